@@ -5,8 +5,11 @@ import (
 
 	"github.com/Sokol111/ecommerce-attribute-service-api/gen/httpapi"
 	"github.com/Sokol111/ecommerce-attribute-service/internal/application"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
 	"github.com/Sokol111/ecommerce-attribute-service/internal/http"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/infrastructure/messaging"
 	"github.com/Sokol111/ecommerce-attribute-service/internal/infrastructure/persistence/mongo"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/infrastructure/reference"
 	"github.com/Sokol111/ecommerce-commons/pkg/modules"
 	"github.com/Sokol111/ecommerce-commons/pkg/swaggerui"
 	"go.uber.org/fx"
@@ -16,13 +19,17 @@ import (
 var AppModules = fx.Options(
 	// Infrastructure
 	modules.NewCoreModule(),
+	mongo.PreMigrationChecks(), // must start before migrations are applied
 	modules.NewPersistenceModule(),
 	modules.NewHTTPModule(),
 	modules.NewObservabilityModule(),
 	modules.NewMessagingModule(),
 	// Domain & Application
 	mongo.Module(),
+	reference.Module(),
+	event.Module(),
 	application.Module(),
+	messaging.Module(),
 
 	// HTTP
 	http.NewHttpHandlerModule(),
@@ -2,22 +2,30 @@ package command
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
 )
 
 type AssignAttributeToCategoryCommand struct {
-	ID          *string
-	CategoryID  string
-	AttributeID string
-	Required    bool
-	SortOrder   int
-	Filterable  *bool
-	Searchable  *bool
-	Enabled     bool
+	ID             *string
+	CategoryID     string
+	AttributeID    string
+	Required       bool
+	SortOrder      int
+	Filterable     *bool
+	Searchable     *bool
+	Enabled        bool
+	GroupID        *string
+	GroupSortOrder int
+	AllowedOptions []string // nil allows all options
+	DefaultValues  []string // nil means no default
 }
 
 type AssignAttributeToCategoryCommandHandler interface {
@@ -25,28 +33,38 @@ type AssignAttributeToCategoryCommandHandler interface {
 }
 
 type assignAttributeToCategoryHandler struct {
-	caRepo   categoryattribute.Repository
-	attrRepo attribute.Repository
+	caRepo       categoryattribute.Repository
+	attrRepo     attribute.Repository
+	refResolver  attribute.ReferenceResolver
+	groupRepo    attributegroup.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
 }
 
 func NewAssignAttributeToCategoryHandler(
 	caRepo categoryattribute.Repository,
 	attrRepo attribute.Repository,
+	refResolver attribute.ReferenceResolver,
+	groupRepo attributegroup.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
 ) AssignAttributeToCategoryCommandHandler {
 	return &assignAttributeToCategoryHandler{
-		caRepo:   caRepo,
-		attrRepo: attrRepo,
+		caRepo:       caRepo,
+		attrRepo:     attrRepo,
+		refResolver:  refResolver,
+		groupRepo:    groupRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
 	}
 }
 
 func (h *assignAttributeToCategoryHandler) Handle(ctx context.Context, cmd AssignAttributeToCategoryCommand) (*categoryattribute.CategoryAttribute, error) {
-	// Verify attribute exists
-	exists, err := h.attrRepo.Exists(ctx, cmd.AttributeID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check attribute existence: %w", err)
-	}
-	if !exists {
-		return nil, fmt.Errorf("attribute not found: %w", persistence.ErrEntityNotFound)
+	if err := checkGroupExists(ctx, h.groupRepo, cmd.GroupID); err != nil {
+		return nil, err
 	}
 
 	var id string
@@ -54,23 +72,133 @@ func (h *assignAttributeToCategoryHandler) Handle(ctx context.Context, cmd Assig
 		id = *cmd.ID
 	}
 
-	ca, err := categoryattribute.NewCategoryAttribute(
-		id,
-		cmd.CategoryID,
-		cmd.AttributeID,
-		cmd.Required,
-		cmd.SortOrder,
-		cmd.Filterable,
-		cmd.Searchable,
-		cmd.Enabled,
-	)
+	var ca *categoryattribute.CategoryAttribute
+	var send outbox.SendFunc
+	_, err := h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		// Read through the lock so a concurrent status change cannot slip past the check below
+		a, err := h.attrRepo.LockForAssignment(txCtx, cmd.AttributeID)
+		if err != nil {
+			if errors.Is(err, persistence.ErrEntityNotFound) {
+				return nil, fmt.Errorf("attribute not found: %w", persistence.ErrEntityNotFound)
+			}
+			return nil, fmt.Errorf("failed to lock attribute: %w", err)
+		}
+
+		// Deprecated and archived attributes stay readable but cannot gain new assignments
+		if !a.IsAssignable() {
+			return nil, fmt.Errorf("attribute is %s: %w", a.Status, attribute.ErrAttributeNotAssignable)
+		}
+
+		defaultValues, err := validateAttributeValues(txCtx, h.refResolver, a, cmd.AllowedOptions, cmd.DefaultValues)
+		if err != nil {
+			return nil, err
+		}
+
+		ca, err = categoryattribute.NewCategoryAttribute(
+			id,
+			cmd.CategoryID,
+			cmd.AttributeID,
+			cmd.Required,
+			cmd.SortOrder,
+			cmd.Filterable,
+			cmd.Searchable,
+			cmd.Enabled,
+			cmd.GroupID,
+			cmd.GroupSortOrder,
+			cmd.AllowedOptions,
+			defaultValues,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create category attribute: %w", err)
+		}
+
+		if err := h.caRepo.Insert(txCtx, ca); err != nil {
+			return nil, fmt.Errorf("failed to insert category attribute: %w", err)
+		}
+
+		sendFunc, err := h.outbox.Create(txCtx, h.eventFactory.NewCategoryAttributeAssignedOutboxMessage(txCtx, ca))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox message: %w", err)
+		}
+		send = sendFunc
+
+		return nil, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create category attribute: %w", err)
+		return nil, err
 	}
 
-	if err := h.caRepo.Insert(ctx, ca); err != nil {
-		return nil, fmt.Errorf("failed to insert category attribute: %w", err)
-	}
+	sendOutboxMessages(ctx, send)
 
 	return ca, nil
 }
+
+// checkGroupExists verifies that an optional group reference points to an existing group
+func checkGroupExists(ctx context.Context, groupRepo attributegroup.Repository, groupID *string) error {
+	if groupID == nil {
+		return nil
+	}
+
+	exists, err := groupRepo.Exists(ctx, *groupID)
+	if err != nil {
+		return fmt.Errorf("failed to check attribute group existence: %w", err)
+	}
+	if !exists {
+		return attributegroup.ErrGroupNotFound
+	}
+
+	return nil
+}
+
+// checkAttributeValues validates an option restriction and default values
+// against the current definition of the attribute and returns the default values to store
+func checkAttributeValues(
+	ctx context.Context,
+	attrRepo attribute.Repository,
+	refResolver attribute.ReferenceResolver,
+	attributeID string,
+	allowedOptions, defaultValues []string,
+) ([]string, error) {
+	if allowedOptions == nil && defaultValues == nil {
+		return nil, nil
+	}
+
+	a, err := attrRepo.FindByID(ctx, attributeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attribute: %w", err)
+	}
+
+	return validateAttributeValues(ctx, refResolver, a, allowedOptions, defaultValues)
+}
+
+// validateAttributeValues returns the default values to store: retired option slugs
+// are replaced with current ones, as only current slugs may be persisted
+func validateAttributeValues(
+	ctx context.Context,
+	refResolver attribute.ReferenceResolver,
+	a *attribute.Attribute,
+	allowedOptions, defaultValues []string,
+) ([]string, error) {
+	if err := a.CheckOptionSlugs(allowedOptions); err != nil {
+		return nil, err
+	}
+
+	if defaultValues == nil {
+		return nil, nil
+	}
+
+	defaultValues = a.CanonicalValues(defaultValues)
+	if errs := a.ValidateValues(defaultValues, allowedOptions); len(errs) > 0 {
+		return nil, fmt.Errorf("%w: %s", categoryattribute.ErrInvalidDefault, errs[0].Message)
+	}
+
+	errs, err := a.ResolveReferences(ctx, refResolver, defaultValues)
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%w: %s", categoryattribute.ErrInvalidDefault, errs[0].Message)
+	}
+
+	return defaultValues, nil
+}
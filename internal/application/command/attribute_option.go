@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// findAttributeVersion loads an attribute and checks the version the caller expects
+func findAttributeVersion(ctx context.Context, repo attribute.Repository, id string, version int) (*attribute.Attribute, error) {
+	a, err := repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return nil, persistence.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to get attribute: %w", err)
+	}
+
+	if a.Version != version {
+		return nil, persistence.ErrOptimisticLocking
+	}
+
+	return a, nil
+}
+
+// syncAssignments brings the assignments of an updated attribute in line with its options:
+// allowed options the update removed are dropped and so are default values the updated
+// attribute no longer accepts. It queues update events of the changed assignments.
+func syncAssignments(
+	ctx context.Context,
+	repo categoryattribute.Repository,
+	ob outbox.Outbox,
+	eventFactory event.Factory,
+	a *attribute.Attribute,
+	assignments []*categoryattribute.CategoryAttribute,
+) ([]outbox.SendFunc, error) {
+	var sends []outbox.SendFunc
+	for _, ca := range assignments {
+		allowedChanged, err := ca.RetainAllowedOptions(a.HasOption)
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", ca.CategoryID, err)
+		}
+		defaultsChanged := ca.ReplaceDefaultValues(a.RetainValidValues(ca.DefaultValues, ca.AllowedOptions))
+		if !allowedChanged && !defaultsChanged {
+			continue
+		}
+
+		updated, err := repo.Update(ctx, ca)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update category attribute: %w", err)
+		}
+
+		send, err := ob.Create(ctx, eventFactory.NewCategoryAttributeUpdatedOutboxMessage(ctx, updated))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox message: %w", err)
+		}
+		sends = append(sends, send)
+	}
+
+	return sends, nil
+}
+
+// migrateOptionSlug points the allowed options and default values of every
+// assignment of the attribute at newSlug and queues their update events
+func migrateOptionSlug(
+	ctx context.Context,
+	repo categoryattribute.Repository,
+	ob outbox.Outbox,
+	eventFactory event.Factory,
+	attributeID string,
+	slug string,
+	newSlug string,
+) ([]outbox.SendFunc, error) {
+	assignments, err := repo.FindAllByAttributeID(ctx, attributeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category assignments: %w", err)
+	}
+
+	var sends []outbox.SendFunc
+	for _, ca := range assignments {
+		if !ca.ReplaceOptionSlug(slug, newSlug) {
+			continue
+		}
+
+		updated, err := repo.Update(ctx, ca)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update category attribute: %w", err)
+		}
+
+		send, err := ob.Create(ctx, eventFactory.NewCategoryAttributeUpdatedOutboxMessage(ctx, updated))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox message: %w", err)
+		}
+		sends = append(sends, send)
+	}
+
+	return sends, nil
+}
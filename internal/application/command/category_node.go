@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// findOrNewNode loads the tree node of a category or creates a root node
+// when no category event has been received for it yet
+func findOrNewNode(ctx context.Context, repo categorytree.Repository, categoryID string) (node *categorytree.Node, isNew bool, err error) {
+	node, err = repo.FindByID(ctx, categoryID)
+	if err == nil {
+		return node, false, nil
+	}
+	if !errors.Is(err, persistence.ErrEntityNotFound) {
+		return nil, false, fmt.Errorf("failed to get category node: %w", err)
+	}
+
+	node, err = categorytree.NewNode(categoryID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create category node: %w", err)
+	}
+	return node, true, nil
+}
+
+func saveNode(ctx context.Context, repo categorytree.Repository, node *categorytree.Node, isNew bool) (*categorytree.Node, error) {
+	if isNew {
+		if err := repo.Insert(ctx, node); err != nil {
+			return nil, fmt.Errorf("failed to insert category node: %w", err)
+		}
+		return node, nil
+	}
+
+	updated, err := repo.Update(ctx, node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update category node: %w", err)
+	}
+	return updated, nil
+}
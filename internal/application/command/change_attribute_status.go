@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// ChangeAttributeStatusCommand moves an attribute along its status lifecycle
+type ChangeAttributeStatusCommand struct {
+	ID      string
+	Version int
+	Status  string
+}
+
+type ChangeAttributeStatusCommandHandler interface {
+	Handle(ctx context.Context, cmd ChangeAttributeStatusCommand) (*attribute.Attribute, error)
+}
+
+type changeAttributeStatusHandler struct {
+	repo         attribute.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
+}
+
+func NewChangeAttributeStatusHandler(
+	repo attribute.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) ChangeAttributeStatusCommandHandler {
+	return &changeAttributeStatusHandler{
+		repo:         repo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *changeAttributeStatusHandler) Handle(ctx context.Context, cmd ChangeAttributeStatusCommand) (*attribute.Attribute, error) {
+	a, err := findAttributeVersion(ctx, h.repo, cmd.ID, cmd.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.ChangeStatus(attribute.AttributeStatus(cmd.Status)); err != nil {
+		return nil, fmt.Errorf("failed to change attribute status: %w", err)
+	}
+
+	var updated *attribute.Attribute
+	var send outbox.SendFunc
+	_, err = h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		result, err := h.repo.Update(txCtx, a)
+		if err != nil {
+			if !errors.Is(err, persistence.ErrOptimisticLocking) {
+				return nil, fmt.Errorf("failed to update attribute: %w", err)
+			}
+			return nil, err
+		}
+
+		sendFunc, err := h.outbox.Create(txCtx, h.eventFactory.NewAttributeUpdatedOutboxMessage(txCtx, result))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox message: %w", err)
+		}
+		updated, send = result, sendFunc
+
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sendOutboxMessages(ctx, send)
+
+	return updated, nil
+}
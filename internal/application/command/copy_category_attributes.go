@@ -0,0 +1,226 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// CopyConflictStrategy decides what happens to attributes already assigned to the target category
+type CopyConflictStrategy string
+
+const (
+	CopyConflictSkip      CopyConflictStrategy = "skip"      // keep the target assignment
+	CopyConflictOverwrite CopyConflictStrategy = "overwrite" // take the configuration of the source assignment
+	CopyConflictFail      CopyConflictStrategy = "fail"      // reject the whole copy
+)
+
+// CopyCategoryAttributesCommand clones the assignments of a source category to a target category
+type CopyCategoryAttributesCommand struct {
+	SourceCategoryID string
+	TargetCategoryID string
+	OnConflict       CopyConflictStrategy
+}
+
+type CopyCategoryAttributesResult struct {
+	Copied      []*categoryattribute.CategoryAttribute // new assignments of the target category
+	Overwritten []*categoryattribute.CategoryAttribute // target assignments updated from the source
+	Skipped     []string                               // attribute IDs left untouched on the target or not assignable any more
+}
+
+type CopyCategoryAttributesCommandHandler interface {
+	Handle(ctx context.Context, cmd CopyCategoryAttributesCommand) (*CopyCategoryAttributesResult, error)
+}
+
+type copyCategoryAttributesHandler struct {
+	repo         categoryattribute.Repository
+	attrRepo     attribute.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
+}
+
+func NewCopyCategoryAttributesHandler(
+	repo categoryattribute.Repository,
+	attrRepo attribute.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) CopyCategoryAttributesCommandHandler {
+	return &copyCategoryAttributesHandler{
+		repo:         repo,
+		attrRepo:     attrRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *copyCategoryAttributesHandler) Handle(ctx context.Context, cmd CopyCategoryAttributesCommand) (*CopyCategoryAttributesResult, error) {
+	if cmd.SourceCategoryID == cmd.TargetCategoryID {
+		return nil, categoryattribute.ErrSameCategory
+	}
+
+	switch cmd.OnConflict {
+	case CopyConflictSkip, CopyConflictOverwrite, CopyConflictFail:
+	default:
+		return nil, fmt.Errorf("invalid conflict strategy: %s", cmd.OnConflict)
+	}
+
+	var result *CopyCategoryAttributesResult
+	var sends []outbox.SendFunc
+	_, err := h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		result, sends = &CopyCategoryAttributesResult{}, nil
+
+		source, err := h.repo.FindAllByCategoryID(txCtx, cmd.SourceCategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source category attributes: %w", err)
+		}
+
+		target, err := h.repo.FindAllByCategoryID(txCtx, cmd.TargetCategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get target category attributes: %w", err)
+		}
+
+		targetByAttribute := lo.KeyBy(target, func(ca *categoryattribute.CategoryAttribute) string {
+			return ca.AttributeID
+		})
+
+		attributes, err := h.attrRepo.FindByIDs(txCtx, lo.Map(source, func(ca *categoryattribute.CategoryAttribute, _ int) string {
+			return ca.AttributeID
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attributes: %w", err)
+		}
+		attributesByID := lo.KeyBy(attributes, func(a *attribute.Attribute) string {
+			return a.ID
+		})
+
+		for _, src := range source {
+			a, found := attributesByID[src.AttributeID]
+			existing, conflict := targetByAttribute[src.AttributeID]
+			if found && !conflict {
+				// New assignments re-read the attribute through a write that conflicts with its concurrent updates
+				if a, err = h.attrRepo.LockForAssignment(txCtx, src.AttributeID); err != nil {
+					if !errors.Is(err, persistence.ErrEntityNotFound) {
+						return nil, fmt.Errorf("failed to lock attribute: %w", err)
+					}
+					found = false
+				}
+			}
+			// Defaults stored before slug normalization may hold retired option slugs
+			if found {
+				src.DefaultValues = a.CanonicalValues(src.DefaultValues)
+			}
+
+			if !conflict {
+				// Deprecated and archived attributes are not assigned anew
+				if !found || !a.IsAssignable() {
+					result.Skipped = append(result.Skipped, src.AttributeID)
+					continue
+				}
+
+				copied, err := h.insertCopy(txCtx, cmd.TargetCategoryID, src)
+				if err != nil {
+					return nil, err
+				}
+				result.Copied = append(result.Copied, copied)
+				continue
+			}
+
+			switch cmd.OnConflict {
+			case CopyConflictSkip:
+				result.Skipped = append(result.Skipped, src.AttributeID)
+			case CopyConflictFail:
+				return nil, fmt.Errorf("attribute %s: %w", src.AttributeID, categoryattribute.ErrAlreadyAssigned)
+			case CopyConflictOverwrite:
+				overwritten, err := h.overwrite(txCtx, existing, src)
+				if err != nil {
+					return nil, err
+				}
+				result.Overwritten = append(result.Overwritten, overwritten)
+			}
+		}
+
+		for _, ca := range result.Copied {
+			send, err := h.outbox.Create(txCtx, h.eventFactory.NewCategoryAttributeAssignedOutboxMessage(txCtx, ca))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create outbox message: %w", err)
+			}
+			sends = append(sends, send)
+		}
+		for _, ca := range result.Overwritten {
+			send, err := h.outbox.Create(txCtx, h.eventFactory.NewCategoryAttributeUpdatedOutboxMessage(txCtx, ca))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create outbox message: %w", err)
+			}
+			sends = append(sends, send)
+		}
+
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sendOutboxMessages(ctx, sends...)
+
+	return result, nil
+}
+
+func (h *copyCategoryAttributesHandler) insertCopy(ctx context.Context, targetCategoryID string, src *categoryattribute.CategoryAttribute) (*categoryattribute.CategoryAttribute, error) {
+	ca, err := categoryattribute.NewCategoryAttribute(
+		"",
+		targetCategoryID,
+		src.AttributeID,
+		src.Required,
+		src.SortOrder,
+		src.Filterable,
+		src.Searchable,
+		src.Enabled,
+		src.GroupID,
+		src.GroupSortOrder,
+		src.AllowedOptions,
+		src.DefaultValues,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category attribute: %w", err)
+	}
+
+	if err := h.repo.Insert(ctx, ca); err != nil {
+		return nil, fmt.Errorf("failed to insert category attribute: %w", err)
+	}
+
+	return ca, nil
+}
+
+func (h *copyCategoryAttributesHandler) overwrite(ctx context.Context, existing, src *categoryattribute.CategoryAttribute) (*categoryattribute.CategoryAttribute, error) {
+	if err := existing.Update(
+		src.Required,
+		src.SortOrder,
+		src.Filterable,
+		src.Searchable,
+		src.Enabled,
+		src.GroupID,
+		src.GroupSortOrder,
+		src.AllowedOptions,
+		src.DefaultValues,
+	); err != nil {
+		return nil, fmt.Errorf("failed to update category attribute: %w", err)
+	}
+
+	updated, err := h.repo.Update(ctx, existing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update category attribute: %w", err)
+	}
+
+	return updated, nil
+}
@@ -8,24 +8,86 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
 )
 
 type OptionInput struct {
-	Name      string
-	Slug      string
-	ColorCode *string
-	SortOrder int
-	Enabled   bool
+	Name         string
+	Slug         string
+	ColorCode    *string
+	Image        *OptionImageInput
+	SortOrder    int
+	Enabled      bool
+	Translations map[string]string // locale -> option name
+}
+
+type OptionImageInput struct {
+	URL string
+	Alt string
+}
+
+type TranslationInput struct {
+	Name string
+	Unit *string
+}
+
+type RangeInput struct {
+	Min       float64
+	Max       float64
+	Step      *float64
+	Precision *int
+}
+
+// DateInput holds optional bounds of a date or datetime attribute in the value format of its type
+type DateInput struct {
+	Min *string
+	Max *string
+}
+
+type DimensionComponentInput struct {
+	Key  string
+	Unit *string
+	Min  float64
+	Max  float64
+}
+
+// DimensionInput lists the components of a dimension attribute in display order
+type DimensionInput struct {
+	Components []DimensionComponentInput
+}
+
+type ReferenceInput struct {
+	TargetKind string
+}
+
+// TextInput holds optional constraints of a text attribute
+type TextInput struct {
+	MinLength *int
+	MaxLength *int
+	Pattern   *string
+	Multiline bool
 }
 
 type CreateAttributeCommand struct {
-	ID      *uuid.UUID
-	Name    string
-	Slug    string
-	Type    string
-	Unit    *string
-	Enabled bool
-	Options []OptionInput
+	ID           *uuid.UUID
+	Name         string
+	Slug         string
+	Status       string // draft or active
+	Type         string
+	Unit         *string
+	Enabled      bool
+	Filterable   bool
+	Searchable   bool
+	Options      []OptionInput
+	Range        *RangeInput
+	Date         *DateInput
+	Dimension    *DimensionInput
+	Reference    *ReferenceInput
+	Text         *TextInput
+	SwatchMode   string                      // text, color or image
+	Translations map[string]TranslationInput // keyed by locale
 }
 
 type CreateAttributeCommandHandler interface {
@@ -33,23 +95,36 @@ type CreateAttributeCommandHandler interface {
 }
 
 type createAttributeHandler struct {
-	repo attribute.Repository
+	repo         attribute.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
 }
 
-func NewCreateAttributeHandler(repo attribute.Repository) CreateAttributeCommandHandler {
+func NewCreateAttributeHandler(
+	repo attribute.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) CreateAttributeCommandHandler {
 	return &createAttributeHandler{
-		repo: repo,
+		repo:         repo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
 	}
 }
 
 func (h *createAttributeHandler) Handle(ctx context.Context, cmd CreateAttributeCommand) (*attribute.Attribute, error) {
 	options := lo.Map(cmd.Options, func(opt OptionInput, _ int) attribute.Option {
 		return attribute.Option{
-			Name:      opt.Name,
-			Slug:      opt.Slug,
-			ColorCode: opt.ColorCode,
-			SortOrder: opt.SortOrder,
-			Enabled:   opt.Enabled,
+			Name:         opt.Name,
+			Slug:         opt.Slug,
+			ColorCode:    opt.ColorCode,
+			Image:        toOptionImage(opt.Image),
+			SortOrder:    opt.SortOrder,
+			Enabled:      opt.Enabled,
+			Translations: opt.Translations,
 		}
 	})
 
@@ -62,18 +137,107 @@ func (h *createAttributeHandler) Handle(ctx context.Context, cmd CreateAttribute
 		id,
 		cmd.Name,
 		cmd.Slug,
+		attribute.AttributeStatus(cmd.Status),
 		attribute.AttributeType(cmd.Type),
 		cmd.Unit,
 		cmd.Enabled,
+		cmd.Filterable,
+		cmd.Searchable,
 		options,
+		toTypeConfig(cmd.Range, cmd.Date, cmd.Dimension, cmd.Reference, cmd.Text, cmd.SwatchMode),
+		toTranslations(cmd.Translations),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create attribute: %w", err)
 	}
 
-	if err := h.repo.Insert(ctx, a); err != nil {
-		return nil, fmt.Errorf("failed to insert attribute: %w", err)
+	var send outbox.SendFunc
+	_, err = h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		if err := h.repo.Insert(txCtx, a); err != nil {
+			return nil, fmt.Errorf("failed to insert attribute: %w", err)
+		}
+
+		sendFunc, err := h.outbox.Create(txCtx, h.eventFactory.NewAttributeCreatedOutboxMessage(txCtx, a))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox message: %w", err)
+		}
+		send = sendFunc
+
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	sendOutboxMessages(ctx, send)
+
 	return a, nil
 }
+
+func toTypeConfig(
+	rangeInput *RangeInput,
+	dateInput *DateInput,
+	dimensionInput *DimensionInput,
+	referenceInput *ReferenceInput,
+	textInput *TextInput,
+	swatchMode string,
+) attribute.TypeConfig {
+	typeConfig := attribute.TypeConfig{SwatchMode: attribute.SwatchMode(swatchMode)}
+	if rangeInput != nil {
+		typeConfig.Range = &attribute.RangeConfig{
+			Min:       rangeInput.Min,
+			Max:       rangeInput.Max,
+			Step:      rangeInput.Step,
+			Precision: rangeInput.Precision,
+		}
+	}
+	if dateInput != nil {
+		typeConfig.Date = &attribute.DateConfig{
+			Min: dateInput.Min,
+			Max: dateInput.Max,
+		}
+	}
+	if dimensionInput != nil {
+		typeConfig.Dimension = &attribute.DimensionConfig{
+			Components: lo.Map(dimensionInput.Components, func(c DimensionComponentInput, _ int) attribute.DimensionComponent {
+				return attribute.DimensionComponent{
+					Key:  c.Key,
+					Unit: c.Unit,
+					Min:  c.Min,
+					Max:  c.Max,
+				}
+			}),
+		}
+	}
+	if referenceInput != nil {
+		typeConfig.Reference = &attribute.ReferenceConfig{TargetKind: referenceInput.TargetKind}
+	}
+	if textInput != nil {
+		typeConfig.Text = &attribute.TextConfig{
+			MinLength: textInput.MinLength,
+			MaxLength: textInput.MaxLength,
+			Pattern:   textInput.Pattern,
+			Multiline: textInput.Multiline,
+		}
+	}
+	return typeConfig
+}
+
+func toOptionImage(input *OptionImageInput) *attribute.OptionImage {
+	if input == nil {
+		return nil
+	}
+	return &attribute.OptionImage{
+		URL: input.URL,
+		Alt: input.Alt,
+	}
+}
+
+func toTranslations(inputs map[string]TranslationInput) map[string]attribute.Translation {
+	return lo.MapValues(inputs, func(t TranslationInput, _ string) attribute.Translation {
+		return attribute.Translation{
+			Name: t.Name,
+			Unit: t.Unit,
+		}
+	})
+}
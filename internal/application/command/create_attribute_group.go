@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
+)
+
+type CreateAttributeGroupCommand struct {
+	ID        *uuid.UUID
+	Name      string
+	Slug      string
+	SortOrder int
+}
+
+type CreateAttributeGroupCommandHandler interface {
+	Handle(ctx context.Context, cmd CreateAttributeGroupCommand) (*attributegroup.AttributeGroup, error)
+}
+
+type createAttributeGroupHandler struct {
+	repo attributegroup.Repository
+}
+
+func NewCreateAttributeGroupHandler(repo attributegroup.Repository) CreateAttributeGroupCommandHandler {
+	return &createAttributeGroupHandler{
+		repo: repo,
+	}
+}
+
+func (h *createAttributeGroupHandler) Handle(ctx context.Context, cmd CreateAttributeGroupCommand) (*attributegroup.AttributeGroup, error) {
+	var id string
+	if cmd.ID != nil {
+		id = cmd.ID.String()
+	}
+
+	g, err := attributegroup.NewAttributeGroup(
+		id,
+		cmd.Name,
+		cmd.Slug,
+		cmd.SortOrder,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attribute group: %w", err)
+	}
+
+	if err := h.repo.Insert(ctx, g); err != nil {
+		return nil, fmt.Errorf("failed to insert attribute group: %w", err)
+	}
+
+	return g, nil
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+type DeleteAttributeCommand struct {
+	ID      string
+	Version int
+	Cascade bool // also remove category assignments of the attribute
+}
+
+type DeleteAttributeCommandHandler interface {
+	Handle(ctx context.Context, cmd DeleteAttributeCommand) error
+}
+
+type deleteAttributeHandler struct {
+	attrRepo     attribute.Repository
+	caRepo       categoryattribute.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
+}
+
+func NewDeleteAttributeHandler(
+	attrRepo attribute.Repository,
+	caRepo categoryattribute.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) DeleteAttributeCommandHandler {
+	return &deleteAttributeHandler{
+		attrRepo:     attrRepo,
+		caRepo:       caRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *deleteAttributeHandler) Handle(ctx context.Context, cmd DeleteAttributeCommand) error {
+	var sends []outbox.SendFunc
+	_, err := h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		sends = nil
+
+		// Assigning transactions write to the attribute through LockForAssignment and so does the
+		// delete below, so a concurrent assignment fails one of them instead of being orphaned
+		assignments, err := h.caRepo.FindAllByAttributeID(txCtx, cmd.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category assignments: %w", err)
+		}
+
+		if len(assignments) > 0 {
+			if !cmd.Cascade {
+				return nil, attribute.ErrAttributeInUse
+			}
+			if err := h.caRepo.DeleteByAttributeID(txCtx, cmd.ID); err != nil {
+				return nil, fmt.Errorf("failed to delete category assignments: %w", err)
+			}
+		}
+
+		if err := h.attrRepo.Delete(txCtx, cmd.ID, cmd.Version); err != nil {
+			if errors.Is(err, persistence.ErrEntityNotFound) || errors.Is(err, persistence.ErrOptimisticLocking) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to delete attribute: %w", err)
+		}
+
+		messages := make([]outbox.Message, 0, len(assignments)+1)
+		for _, ca := range assignments {
+			messages = append(messages, h.eventFactory.NewCategoryAttributeUnassignedOutboxMessage(txCtx, ca))
+		}
+		messages = append(messages, h.eventFactory.NewAttributeDeletedOutboxMessage(txCtx, cmd.ID, cmd.Version))
+
+		for _, msg := range messages {
+			send, err := h.outbox.Create(txCtx, msg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create outbox message: %w", err)
+			}
+			sends = append(sends, send)
+		}
+
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sendOutboxMessages(ctx, sends...)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+type DeleteAttributeGroupCommand struct {
+	ID      string
+	Version int
+}
+
+type DeleteAttributeGroupCommandHandler interface {
+	Handle(ctx context.Context, cmd DeleteAttributeGroupCommand) error
+}
+
+type deleteAttributeGroupHandler struct {
+	groupRepo attributegroup.Repository
+	caRepo    categoryattribute.Repository
+	txManager persistence.TxManager
+}
+
+func NewDeleteAttributeGroupHandler(
+	groupRepo attributegroup.Repository,
+	caRepo categoryattribute.Repository,
+	txManager persistence.TxManager,
+) DeleteAttributeGroupCommandHandler {
+	return &deleteAttributeGroupHandler{
+		groupRepo: groupRepo,
+		caRepo:    caRepo,
+		txManager: txManager,
+	}
+}
+
+func (h *deleteAttributeGroupHandler) Handle(ctx context.Context, cmd DeleteAttributeGroupCommand) error {
+	_, err := h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		referenced, err := h.caRepo.ExistsByGroupID(txCtx, cmd.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check category attribute references: %w", err)
+		}
+		if referenced {
+			return nil, attributegroup.ErrGroupInUse
+		}
+
+		if err := h.groupRepo.Delete(txCtx, cmd.ID, cmd.Version); err != nil {
+			if errors.Is(err, persistence.ErrEntityNotFound) || errors.Is(err, persistence.ErrOptimisticLocking) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to delete attribute group: %w", err)
+		}
+
+		return nil, nil
+	})
+	return err
+}
@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// DisableCategoryAssignmentsCommand marks every attribute assignment of a category
+// as disabled by its category, e.g. after the category was disabled in the category service.
+// The Enabled flags set by merchants are kept.
+type DisableCategoryAssignmentsCommand struct {
+	CategoryID string
+}
+
+type DisableCategoryAssignmentsCommandHandler interface {
+	Handle(ctx context.Context, cmd DisableCategoryAssignmentsCommand) error
+}
+
+type disableCategoryAssignmentsHandler struct {
+	repo         categoryattribute.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
+}
+
+func NewDisableCategoryAssignmentsHandler(
+	repo categoryattribute.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) DisableCategoryAssignmentsCommandHandler {
+	return &disableCategoryAssignmentsHandler{
+		repo:         repo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *disableCategoryAssignmentsHandler) Handle(ctx context.Context, cmd DisableCategoryAssignmentsCommand) error {
+	var sends []outbox.SendFunc
+	_, err := h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		sends = nil
+
+		assignments, err := h.repo.FindAllByCategoryID(txCtx, cmd.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category assignments: %w", err)
+		}
+
+		for _, ca := range assignments {
+			// Already marked assignments are left untouched so redelivery is a no-op
+			if !ca.MarkCategoryDisabled() {
+				continue
+			}
+
+			updated, err := h.repo.Update(txCtx, ca)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update category attribute: %w", err)
+			}
+
+			send, err := h.outbox.Create(txCtx, h.eventFactory.NewCategoryAttributeUpdatedOutboxMessage(txCtx, updated))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create outbox message: %w", err)
+			}
+			sends = append(sends, send)
+		}
+
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sendOutboxMessages(ctx, sends...)
+
+	return nil
+}
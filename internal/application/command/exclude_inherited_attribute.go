@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// ExcludeInheritedAttributeCommand stops a category and its descendants
+// from inheriting an attribute assigned to one of its ancestors
+type ExcludeInheritedAttributeCommand struct {
+	CategoryID  string
+	AttributeID string
+}
+
+type ExcludeInheritedAttributeCommandHandler interface {
+	Handle(ctx context.Context, cmd ExcludeInheritedAttributeCommand) (*categorytree.Node, error)
+}
+
+type excludeInheritedAttributeHandler struct {
+	repo     categorytree.Repository
+	attrRepo attribute.Repository
+}
+
+func NewExcludeInheritedAttributeHandler(
+	repo categorytree.Repository,
+	attrRepo attribute.Repository,
+) ExcludeInheritedAttributeCommandHandler {
+	return &excludeInheritedAttributeHandler{
+		repo:     repo,
+		attrRepo: attrRepo,
+	}
+}
+
+func (h *excludeInheritedAttributeHandler) Handle(ctx context.Context, cmd ExcludeInheritedAttributeCommand) (*categorytree.Node, error) {
+	exists, err := h.attrRepo.Exists(ctx, cmd.AttributeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check attribute existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("attribute not found: %w", persistence.ErrEntityNotFound)
+	}
+
+	node, isNew, err := findOrNewNode(ctx, h.repo, cmd.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := node.Exclude(cmd.AttributeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exclude attribute: %w", err)
+	}
+	if !changed {
+		return node, nil
+	}
+
+	return saveNode(ctx, h.repo, node, isNew)
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// IncludeInheritedAttributeCommand lifts an exclusion made by ExcludeInheritedAttributeCommand
+type IncludeInheritedAttributeCommand struct {
+	CategoryID  string
+	AttributeID string
+}
+
+type IncludeInheritedAttributeCommandHandler interface {
+	Handle(ctx context.Context, cmd IncludeInheritedAttributeCommand) error
+}
+
+type includeInheritedAttributeHandler struct {
+	repo categorytree.Repository
+}
+
+func NewIncludeInheritedAttributeHandler(repo categorytree.Repository) IncludeInheritedAttributeCommandHandler {
+	return &includeInheritedAttributeHandler{
+		repo: repo,
+	}
+}
+
+func (h *includeInheritedAttributeHandler) Handle(ctx context.Context, cmd IncludeInheritedAttributeCommand) error {
+	node, err := h.repo.FindByID(ctx, cmd.CategoryID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return persistence.ErrEntityNotFound
+		}
+		return fmt.Errorf("failed to get category node: %w", err)
+	}
+
+	if !node.Include(cmd.AttributeID) {
+		return persistence.ErrEntityNotFound
+	}
+
+	_, err = saveNode(ctx, h.repo, node, false)
+	return err
+}
@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// MergeAttributeOptionsCommand folds the source option into the target option.
+// The source slug resolves to the target from then on and category assignments
+// are migrated to the target slug.
+type MergeAttributeOptionsCommand struct {
+	AttributeID string
+	Version     int
+	SourceSlug  string
+	TargetSlug  string
+}
+
+type MergeAttributeOptionsCommandHandler interface {
+	Handle(ctx context.Context, cmd MergeAttributeOptionsCommand) (*attribute.Attribute, error)
+}
+
+type mergeAttributeOptionsHandler struct {
+	repo         attribute.Repository
+	caRepo       categoryattribute.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
+}
+
+func NewMergeAttributeOptionsHandler(
+	repo attribute.Repository,
+	caRepo categoryattribute.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) MergeAttributeOptionsCommandHandler {
+	return &mergeAttributeOptionsHandler{
+		repo:         repo,
+		caRepo:       caRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *mergeAttributeOptionsHandler) Handle(ctx context.Context, cmd MergeAttributeOptionsCommand) (*attribute.Attribute, error) {
+	a, err := findAttributeVersion(ctx, h.repo, cmd.AttributeID, cmd.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.MergeOptions(cmd.SourceSlug, cmd.TargetSlug); err != nil {
+		return nil, fmt.Errorf("failed to merge attribute options: %w", err)
+	}
+
+	var updated *attribute.Attribute
+	var sends []outbox.SendFunc
+	_, err = h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		sends = nil
+
+		result, err := h.repo.Update(txCtx, a)
+		if err != nil {
+			if !errors.Is(err, persistence.ErrOptimisticLocking) {
+				return nil, fmt.Errorf("failed to update attribute: %w", err)
+			}
+			return nil, err
+		}
+
+		for _, msg := range []outbox.Message{
+			h.eventFactory.NewAttributeOptionsMergedOutboxMessage(txCtx, result, cmd.SourceSlug, cmd.TargetSlug),
+			h.eventFactory.NewAttributeUpdatedOutboxMessage(txCtx, result),
+		} {
+			send, err := h.outbox.Create(txCtx, msg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create outbox message: %w", err)
+			}
+			sends = append(sends, send)
+		}
+
+		migrated, err := migrateOptionSlug(txCtx, h.caRepo, h.outbox, h.eventFactory, result.ID, cmd.SourceSlug, cmd.TargetSlug)
+		if err != nil {
+			return nil, err
+		}
+		sends = append(sends, migrated...)
+		updated = result
+
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sendOutboxMessages(ctx, sends...)
+
+	return updated, nil
+}
@@ -0,0 +1,20 @@
+package command
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+// sendOutboxMessages hands committed outbox messages over for immediate delivery.
+// Failures are only logged: undelivered messages are picked up later by the outbox fetcher.
+func sendOutboxMessages(ctx context.Context, sends ...outbox.SendFunc) {
+	for _, send := range sends {
+		if err := send(ctx); err != nil {
+			logger.Get(ctx).Warn("failed to send outbox message", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// RemoveCategoryAssignmentsCommand removes every attribute assignment of a category and
+// leaves a tombstone in its tree node, e.g. after the category was deleted in the category service
+type RemoveCategoryAssignmentsCommand struct {
+	CategoryID      string
+	CategoryVersion int
+}
+
+type RemoveCategoryAssignmentsCommandHandler interface {
+	Handle(ctx context.Context, cmd RemoveCategoryAssignmentsCommand) error
+}
+
+type removeCategoryAssignmentsHandler struct {
+	repo         categoryattribute.Repository
+	treeRepo     categorytree.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
+}
+
+func NewRemoveCategoryAssignmentsHandler(
+	repo categoryattribute.Repository,
+	treeRepo categorytree.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) RemoveCategoryAssignmentsCommandHandler {
+	return &removeCategoryAssignmentsHandler{
+		repo:         repo,
+		treeRepo:     treeRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *removeCategoryAssignmentsHandler) Handle(ctx context.Context, cmd RemoveCategoryAssignmentsCommand) error {
+	var sends []outbox.SendFunc
+	_, err := h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		sends = nil
+
+		if err := h.markNodeDeleted(txCtx, cmd); err != nil {
+			return nil, err
+		}
+
+		assignments, err := h.repo.FindAllByCategoryID(txCtx, cmd.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category assignments: %w", err)
+		}
+
+		// Nothing left to remove, e.g. the event is redelivered
+		if len(assignments) == 0 {
+			return nil, nil
+		}
+
+		if err := h.repo.DeleteByCategoryID(txCtx, cmd.CategoryID); err != nil {
+			return nil, fmt.Errorf("failed to delete category assignments: %w", err)
+		}
+
+		for _, ca := range assignments {
+			send, err := h.outbox.Create(txCtx, h.eventFactory.NewCategoryAttributeUnassignedOutboxMessage(txCtx, ca))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create outbox message: %w", err)
+			}
+			sends = append(sends, send)
+		}
+
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sendOutboxMessages(ctx, sends...)
+
+	return nil
+}
+
+// markNodeDeleted keeps a tombstone instead of deleting the node, so redelivered or reordered
+// category events cannot recreate it, and detaches the children of the category
+func (h *removeCategoryAssignmentsHandler) markNodeDeleted(ctx context.Context, cmd RemoveCategoryAssignmentsCommand) error {
+	node, isNew, err := findOrNewNode(ctx, h.treeRepo, cmd.CategoryID)
+	if err != nil {
+		return err
+	}
+	if node.MarkDeleted(cmd.CategoryVersion) {
+		if _, err := saveNode(ctx, h.treeRepo, node, isNew); err != nil {
+			return err
+		}
+	}
+
+	children, err := h.treeRepo.FindChildren(ctx, cmd.CategoryID)
+	if err != nil {
+		return fmt.Errorf("failed to get child category nodes: %w", err)
+	}
+	for _, child := range children {
+		child.Detach()
+		if _, err := saveNode(ctx, h.treeRepo, child, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// RenameAttributeOptionCommand changes the slug of an option. The old slug keeps
+// resolving to the option and category assignments are migrated to the new one.
+type RenameAttributeOptionCommand struct {
+	AttributeID string
+	Version     int
+	Slug        string
+	NewSlug     string
+}
+
+type RenameAttributeOptionCommandHandler interface {
+	Handle(ctx context.Context, cmd RenameAttributeOptionCommand) (*attribute.Attribute, error)
+}
+
+type renameAttributeOptionHandler struct {
+	repo         attribute.Repository
+	caRepo       categoryattribute.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
+}
+
+func NewRenameAttributeOptionHandler(
+	repo attribute.Repository,
+	caRepo categoryattribute.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) RenameAttributeOptionCommandHandler {
+	return &renameAttributeOptionHandler{
+		repo:         repo,
+		caRepo:       caRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *renameAttributeOptionHandler) Handle(ctx context.Context, cmd RenameAttributeOptionCommand) (*attribute.Attribute, error) {
+	a, err := findAttributeVersion(ctx, h.repo, cmd.AttributeID, cmd.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.RenameOption(cmd.Slug, cmd.NewSlug); err != nil {
+		return nil, fmt.Errorf("failed to rename attribute option: %w", err)
+	}
+
+	var updated *attribute.Attribute
+	var sends []outbox.SendFunc
+	_, err = h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		sends = nil
+
+		result, err := h.repo.Update(txCtx, a)
+		if err != nil {
+			if !errors.Is(err, persistence.ErrOptimisticLocking) {
+				return nil, fmt.Errorf("failed to update attribute: %w", err)
+			}
+			return nil, err
+		}
+
+		for _, msg := range []outbox.Message{
+			h.eventFactory.NewAttributeOptionRenamedOutboxMessage(txCtx, result, cmd.Slug, cmd.NewSlug),
+			h.eventFactory.NewAttributeUpdatedOutboxMessage(txCtx, result),
+		} {
+			send, err := h.outbox.Create(txCtx, msg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create outbox message: %w", err)
+			}
+			sends = append(sends, send)
+		}
+
+		migrated, err := migrateOptionSlug(txCtx, h.caRepo, h.outbox, h.eventFactory, result.ID, cmd.Slug, cmd.NewSlug)
+		if err != nil {
+			return nil, err
+		}
+		sends = append(sends, migrated...)
+		updated = result
+
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sendOutboxMessages(ctx, sends...)
+
+	return updated, nil
+}
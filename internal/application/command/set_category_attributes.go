@@ -0,0 +1,299 @@
+package command
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+// BulkMode controls what happens to assignments missing from a bulk list
+type BulkMode string
+
+const (
+	BulkModeReplace BulkMode = "replace" // missing assignments are removed
+	BulkModeUpsert  BulkMode = "upsert"  // missing assignments are kept after the listed ones
+)
+
+type BulkCategoryAttributeInput struct {
+	AttributeID    string
+	Required       bool
+	Filterable     *bool
+	Searchable     *bool
+	Enabled        bool
+	GroupID        *string
+	GroupSortOrder int
+	AllowedOptions []string // nil allows all options
+	DefaultValues  []string // nil means no default
+}
+
+// SetCategoryAttributesCommand writes the ordered attribute list of a category
+// in one transaction. The position of an item becomes its SortOrder; in upsert mode
+// assignments missing from the list follow the listed ones in their previous order.
+type SetCategoryAttributesCommand struct {
+	CategoryID string
+	Mode       BulkMode
+	Items      []BulkCategoryAttributeInput
+}
+
+// BulkItemError describes why an item of a bulk list was rejected
+type BulkItemError struct {
+	Index       int
+	AttributeID string
+	Message     string
+}
+
+// InvalidBulkItemsError is returned when any item of a bulk list is rejected; nothing is written then
+type InvalidBulkItemsError struct {
+	Items []BulkItemError
+}
+
+func (e *InvalidBulkItemsError) Error() string {
+	messages := lo.Map(e.Items, func(item BulkItemError, _ int) string {
+		return fmt.Sprintf("item %d: %s", item.Index, item.Message)
+	})
+	return "invalid bulk items: " + strings.Join(messages, "; ")
+}
+
+type SetCategoryAttributesCommandHandler interface {
+	// Handle returns all assignments of the category after the write
+	Handle(ctx context.Context, cmd SetCategoryAttributesCommand) ([]*categoryattribute.CategoryAttribute, error)
+}
+
+type setCategoryAttributesHandler struct {
+	caRepo       categoryattribute.Repository
+	attrRepo     attribute.Repository
+	refResolver  attribute.ReferenceResolver
+	groupRepo    attributegroup.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
+}
+
+func NewSetCategoryAttributesHandler(
+	caRepo categoryattribute.Repository,
+	attrRepo attribute.Repository,
+	refResolver attribute.ReferenceResolver,
+	groupRepo attributegroup.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) SetCategoryAttributesCommandHandler {
+	return &setCategoryAttributesHandler{
+		caRepo:       caRepo,
+		attrRepo:     attrRepo,
+		refResolver:  refResolver,
+		groupRepo:    groupRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
+	}
+}
+
+func (h *setCategoryAttributesHandler) Handle(ctx context.Context, cmd SetCategoryAttributesCommand) ([]*categoryattribute.CategoryAttribute, error) {
+	if cmd.Mode != BulkModeReplace && cmd.Mode != BulkModeUpsert {
+		return nil, fmt.Errorf("invalid bulk mode: %s", cmd.Mode)
+	}
+
+	attributesByID, err := h.checkReferences(ctx, cmd.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*categoryattribute.CategoryAttribute
+	var send outbox.SendFunc
+	_, err = h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		existing, err := h.caRepo.FindAllByCategoryID(txCtx, cmd.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category attributes: %w", err)
+		}
+
+		existingByAttribute := lo.KeyBy(existing, func(ca *categoryattribute.CategoryAttribute) string {
+			return ca.AttributeID
+		})
+
+		var itemErrs []BulkItemError
+		toWrite := make([]*categoryattribute.CategoryAttribute, 0, len(cmd.Items))
+		isNew := make(map[string]bool, len(cmd.Items))
+		for i, item := range cmd.Items {
+			ca, ok := existingByAttribute[item.AttributeID]
+			a := attributesByID[item.AttributeID]
+			if !ok {
+				// New assignments re-read the attribute through a write that conflicts with its concurrent updates
+				if a, err = h.attrRepo.LockForAssignment(txCtx, item.AttributeID); err != nil {
+					if !errors.Is(err, persistence.ErrEntityNotFound) {
+						return nil, fmt.Errorf("failed to lock attribute: %w", err)
+					}
+					itemErrs = append(itemErrs, BulkItemError{Index: i, AttributeID: item.AttributeID, Message: "attribute not found"})
+					continue
+				}
+			}
+			if !ok && !a.IsAssignable() {
+				message := fmt.Sprintf("attribute is %s and cannot be newly assigned", a.Status)
+				itemErrs = append(itemErrs, BulkItemError{Index: i, AttributeID: item.AttributeID, Message: message})
+				continue
+			}
+			// checkReferences validated the defaults, only current option slugs are stored
+			defaultValues := a.CanonicalValues(item.DefaultValues)
+			if ok {
+				err = ca.Update(item.Required, i, item.Filterable, item.Searchable, item.Enabled,
+					item.GroupID, item.GroupSortOrder, item.AllowedOptions, defaultValues)
+			} else {
+				ca, err = categoryattribute.NewCategoryAttribute("", cmd.CategoryID, item.AttributeID, item.Required, i,
+					item.Filterable, item.Searchable, item.Enabled, item.GroupID, item.GroupSortOrder,
+					item.AllowedOptions, defaultValues)
+			}
+			if err != nil {
+				itemErrs = append(itemErrs, BulkItemError{Index: i, AttributeID: item.AttributeID, Message: err.Error()})
+				continue
+			}
+			isNew[ca.ID] = !ok
+			toWrite = append(toWrite, ca)
+		}
+		if len(itemErrs) > 0 {
+			return nil, &InvalidBulkItemsError{Items: itemErrs}
+		}
+
+		listed := lo.SliceToMap(cmd.Items, func(item BulkCategoryAttributeInput) (string, bool) {
+			return item.AttributeID, true
+		})
+
+		var kept, removed []*categoryattribute.CategoryAttribute
+		for _, ca := range existing {
+			switch {
+			case listed[ca.AttributeID]:
+			case cmd.Mode == BulkModeReplace:
+				removed = append(removed, ca)
+			default:
+				kept = append(kept, ca)
+			}
+		}
+
+		// Removals go first so a replaced list never trips the category/attribute unique index
+		for _, ca := range removed {
+			if err := h.caRepo.Delete(txCtx, ca.ID); err != nil {
+				return nil, fmt.Errorf("failed to delete category attribute: %w", err)
+			}
+		}
+
+		written := make([]*categoryattribute.CategoryAttribute, 0, len(toWrite)+len(kept))
+		for _, ca := range toWrite {
+			if isNew[ca.ID] {
+				if err := h.caRepo.Insert(txCtx, ca); err != nil {
+					return nil, fmt.Errorf("failed to insert category attribute: %w", err)
+				}
+				written = append(written, ca)
+				continue
+			}
+
+			updated, err := h.caRepo.Update(txCtx, ca)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update category attribute: %w", err)
+			}
+			written = append(written, updated)
+		}
+
+		slices.SortStableFunc(kept, func(a, b *categoryattribute.CategoryAttribute) int {
+			return cmp.Compare(a.SortOrder, b.SortOrder)
+		})
+		for i, ca := range kept {
+			if !ca.MoveTo(len(cmd.Items) + i) {
+				written = append(written, ca)
+				continue
+			}
+
+			updated, err := h.caRepo.Update(txCtx, ca)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update category attribute: %w", err)
+			}
+			written = append(written, updated)
+		}
+
+		sendFunc, err := h.outbox.Create(txCtx, h.eventFactory.NewCategoryAttributesBulkUpdatedOutboxMessage(
+			txCtx, cmd.CategoryID, string(cmd.Mode), written, removed))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox message: %w", err)
+		}
+		result, send = written, sendFunc
+
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sendOutboxMessages(ctx, send)
+
+	return result, nil
+}
+
+// checkReferences rejects duplicated or unknown attributes, unknown groups,
+// unknown allowed options and unsuitable default values before any write.
+// It returns the listed attributes keyed by ID.
+func (h *setCategoryAttributesHandler) checkReferences(ctx context.Context, items []BulkCategoryAttributeInput) (map[string]*attribute.Attribute, error) {
+	attributes, err := h.attrRepo.FindByIDs(ctx, lo.Uniq(lo.Map(items, func(item BulkCategoryAttributeInput, _ int) string {
+		return item.AttributeID
+	})))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+	attributesByID := lo.KeyBy(attributes, func(a *attribute.Attribute) string {
+		return a.ID
+	})
+
+	groupIDs := lo.Uniq(lo.FilterMap(items, func(item BulkCategoryAttributeInput, _ int) (string, bool) {
+		return lo.FromPtr(item.GroupID), item.GroupID != nil
+	}))
+	groups, err := h.groupRepo.FindByIDs(ctx, groupIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attribute groups: %w", err)
+	}
+	knownGroups := lo.SliceToMap(groups, func(g *attributegroup.AttributeGroup) (string, bool) {
+		return g.ID, true
+	})
+
+	var itemErrs []BulkItemError
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		a, known := attributesByID[item.AttributeID]
+
+		var message string
+		switch {
+		case seen[item.AttributeID]:
+			message = "attribute is listed more than once"
+		case !known:
+			message = "attribute not found"
+		case item.GroupID != nil && !knownGroups[*item.GroupID]:
+			message = "attribute group not found"
+		default:
+			_, err := validateAttributeValues(ctx, h.refResolver, a, item.AllowedOptions, item.DefaultValues)
+			switch {
+			case errors.Is(err, attribute.ErrUnknownOption), errors.Is(err, categoryattribute.ErrInvalidDefault):
+				message = err.Error()
+			case err != nil:
+				return nil, err
+			}
+		}
+		seen[item.AttributeID] = true
+
+		if message != "" {
+			itemErrs = append(itemErrs, BulkItemError{Index: i, AttributeID: item.AttributeID, Message: message})
+		}
+	}
+
+	if len(itemErrs) > 0 {
+		return nil, &InvalidBulkItemsError{Items: itemErrs}
+	}
+	return attributesByID, nil
+}
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+)
+
+// SyncCategoryParentCommand mirrors the parent relation of a category
+// published by the category service
+type SyncCategoryParentCommand struct {
+	CategoryID      string
+	ParentID        *string
+	CategoryVersion int
+}
+
+type SyncCategoryParentCommandHandler interface {
+	Handle(ctx context.Context, cmd SyncCategoryParentCommand) error
+}
+
+type syncCategoryParentHandler struct {
+	repo categorytree.Repository
+}
+
+func NewSyncCategoryParentHandler(repo categorytree.Repository) SyncCategoryParentCommandHandler {
+	return &syncCategoryParentHandler{
+		repo: repo,
+	}
+}
+
+func (h *syncCategoryParentHandler) Handle(ctx context.Context, cmd SyncCategoryParentCommand) error {
+	node, isNew, err := findOrNewNode(ctx, h.repo, cmd.CategoryID)
+	if err != nil {
+		return err
+	}
+
+	changed, err := node.SetParent(cmd.ParentID, cmd.CategoryVersion)
+	if err != nil {
+		return err
+	}
+	// Stale or redelivered event
+	if !changed {
+		return nil
+	}
+
+	if cmd.ParentID != nil {
+		ancestors, err := h.repo.FindPath(ctx, *cmd.ParentID)
+		if err != nil {
+			return fmt.Errorf("failed to get category ancestors: %w", err)
+		}
+		for _, ancestor := range ancestors {
+			if ancestor.ID == cmd.CategoryID {
+				return categorytree.ErrCyclicParent
+			}
+		}
+	}
+
+	_, err = saveNode(ctx, h.repo, node, isNew)
+	return err
+}
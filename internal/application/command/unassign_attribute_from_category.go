@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
 )
 
@@ -19,12 +21,23 @@ type UnassignAttributeFromCategoryCommandHandler interface {
 }
 
 type unassignAttributeFromCategoryHandler struct {
-	repo categoryattribute.Repository
+	repo         categoryattribute.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
 }
 
-func NewUnassignAttributeFromCategoryHandler(repo categoryattribute.Repository) UnassignAttributeFromCategoryCommandHandler {
+func NewUnassignAttributeFromCategoryHandler(
+	repo categoryattribute.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) UnassignAttributeFromCategoryCommandHandler {
 	return &unassignAttributeFromCategoryHandler{
-		repo: repo,
+		repo:         repo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
 	}
 }
 
@@ -42,9 +55,25 @@ func (h *unassignAttributeFromCategoryHandler) Handle(ctx context.Context, cmd U
 		return persistence.ErrEntityNotFound
 	}
 
-	if err := h.repo.Delete(ctx, cmd.ID); err != nil {
-		return fmt.Errorf("failed to delete category attribute: %w", err)
+	var send outbox.SendFunc
+	_, err = h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		if err := h.repo.Delete(txCtx, cmd.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete category attribute: %w", err)
+		}
+
+		sendFunc, err := h.outbox.Create(txCtx, h.eventFactory.NewCategoryAttributeUnassignedOutboxMessage(txCtx, ca))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox message: %w", err)
+		}
+		send = sendFunc
+
+		return nil, nil
+	})
+	if err != nil {
+		return err
 	}
 
+	sendOutboxMessages(ctx, send)
+
 	return nil
 }
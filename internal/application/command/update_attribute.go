@@ -8,18 +8,30 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
 )
 
 type UpdateAttributeCommand struct {
-	ID      string
-	Version int
-	Name    string
-	Slug    string
-	Type    string
-	Unit    *string
-	Enabled bool
-	Options []OptionInput
+	ID           string
+	Version      int
+	Name         string
+	Slug         string
+	Type         string
+	Unit         *string
+	Enabled      bool
+	Filterable   *bool // nil keeps the current value
+	Searchable   *bool // nil keeps the current value
+	Options      []OptionInput
+	Range        *RangeInput
+	Date         *DateInput
+	Dimension    *DimensionInput
+	Reference    *ReferenceInput
+	Text         *TextInput
+	SwatchMode   string                      // text, color or image
+	Translations map[string]TranslationInput // keyed by locale
 }
 
 type UpdateAttributeCommandHandler interface {
@@ -27,58 +39,114 @@ type UpdateAttributeCommandHandler interface {
 }
 
 type updateAttributeHandler struct {
-	repo attribute.Repository
+	repo         attribute.Repository
+	caRepo       categoryattribute.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
 }
 
-func NewUpdateAttributeHandler(repo attribute.Repository) UpdateAttributeCommandHandler {
+func NewUpdateAttributeHandler(
+	repo attribute.Repository,
+	caRepo categoryattribute.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) UpdateAttributeCommandHandler {
 	return &updateAttributeHandler{
-		repo: repo,
+		repo:         repo,
+		caRepo:       caRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
 	}
 }
 
 func (h *updateAttributeHandler) Handle(ctx context.Context, cmd UpdateAttributeCommand) (*attribute.Attribute, error) {
-	a, err := h.repo.FindByID(ctx, cmd.ID)
-	if err != nil {
-		if errors.Is(err, persistence.ErrEntityNotFound) {
-			return nil, persistence.ErrEntityNotFound
+	options := lo.Map(cmd.Options, func(opt OptionInput, _ int) attribute.Option {
+		return attribute.Option{
+			Name:         opt.Name,
+			Slug:         opt.Slug,
+			ColorCode:    opt.ColorCode,
+			Image:        toOptionImage(opt.Image),
+			SortOrder:    opt.SortOrder,
+			Enabled:      opt.Enabled,
+			Translations: opt.Translations,
 		}
-		return nil, fmt.Errorf("failed to get attribute: %w", err)
-	}
+	})
 
-	if a.Version != cmd.Version {
-		return nil, persistence.ErrOptimisticLocking
-	}
+	var updated *attribute.Attribute
+	var sends []outbox.SendFunc
+	_, err := h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		sends = nil
 
-	attrType := attribute.AttributeType(cmd.Type)
+		a, err := h.repo.FindByID(txCtx, cmd.ID)
+		if err != nil {
+			if errors.Is(err, persistence.ErrEntityNotFound) {
+				return nil, persistence.ErrEntityNotFound
+			}
+			return nil, fmt.Errorf("failed to get attribute: %w", err)
+		}
 
-	options := lo.Map(cmd.Options, func(opt OptionInput, _ int) attribute.Option {
-		return attribute.Option{
-			Name:      opt.Name,
-			Slug:      opt.Slug,
-			ColorCode: opt.ColorCode,
-			SortOrder: opt.SortOrder,
-			Enabled:   opt.Enabled,
+		if a.Version != cmd.Version {
+			return nil, persistence.ErrOptimisticLocking
 		}
-	})
 
-	if err := a.Update(
-		cmd.Name,
-		cmd.Slug,
-		attrType,
-		cmd.Unit,
-		cmd.Enabled,
-		options,
-	); err != nil {
-		return nil, fmt.Errorf("failed to update attribute: %w", err)
-	}
+		// Assigning transactions write to the attribute through LockForAssignment, so a concurrent
+		// assignment makes one of the two transactions fail with a write conflict and retry
+		assignments, err := h.caRepo.FindAllByAttributeID(txCtx, a.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category assignments: %w", err)
+		}
 
-	updated, err := h.repo.Update(ctx, a)
-	if err != nil {
-		if !errors.Is(err, persistence.ErrOptimisticLocking) {
+		if len(assignments) > 0 {
+			if err := a.CheckAssignedTypeChange(attribute.AttributeType(cmd.Type)); err != nil {
+				return nil, fmt.Errorf("failed to update attribute: %w", err)
+			}
+		}
+
+		if err := a.Update(
+			cmd.Name,
+			cmd.Slug,
+			attribute.AttributeType(cmd.Type),
+			cmd.Unit,
+			cmd.Enabled,
+			lo.FromPtrOr(cmd.Filterable, a.Filterable),
+			lo.FromPtrOr(cmd.Searchable, a.Searchable),
+			options,
+			toTypeConfig(cmd.Range, cmd.Date, cmd.Dimension, cmd.Reference, cmd.Text, cmd.SwatchMode),
+			toTranslations(cmd.Translations),
+		); err != nil {
 			return nil, fmt.Errorf("failed to update attribute: %w", err)
 		}
+
+		result, err := h.repo.Update(txCtx, a)
+		if err != nil {
+			if !errors.Is(err, persistence.ErrOptimisticLocking) {
+				return nil, fmt.Errorf("failed to update attribute: %w", err)
+			}
+			return nil, err
+		}
+
+		sendFunc, err := h.outbox.Create(txCtx, h.eventFactory.NewAttributeUpdatedOutboxMessage(txCtx, result))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox message: %w", err)
+		}
+		updated, sends = result, append(sends, sendFunc)
+
+		assignmentSends, err := syncAssignments(txCtx, h.caRepo, h.outbox, h.eventFactory, result, assignments)
+		if err != nil {
+			return nil, err
+		}
+		sends = append(sends, assignmentSends...)
+
+		return nil, nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	sendOutboxMessages(ctx, sends...)
+
 	return updated, nil
 }
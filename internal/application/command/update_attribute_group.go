@@ -0,0 +1,64 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+type UpdateAttributeGroupCommand struct {
+	ID        string
+	Version   int
+	Name      string
+	Slug      string
+	SortOrder int
+}
+
+type UpdateAttributeGroupCommandHandler interface {
+	Handle(ctx context.Context, cmd UpdateAttributeGroupCommand) (*attributegroup.AttributeGroup, error)
+}
+
+type updateAttributeGroupHandler struct {
+	repo attributegroup.Repository
+}
+
+func NewUpdateAttributeGroupHandler(repo attributegroup.Repository) UpdateAttributeGroupCommandHandler {
+	return &updateAttributeGroupHandler{
+		repo: repo,
+	}
+}
+
+func (h *updateAttributeGroupHandler) Handle(ctx context.Context, cmd UpdateAttributeGroupCommand) (*attributegroup.AttributeGroup, error) {
+	g, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return nil, persistence.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to get attribute group: %w", err)
+	}
+
+	if g.Version != cmd.Version {
+		return nil, persistence.ErrOptimisticLocking
+	}
+
+	if err := g.Update(
+		cmd.Name,
+		cmd.Slug,
+		cmd.SortOrder,
+	); err != nil {
+		return nil, fmt.Errorf("failed to update attribute group: %w", err)
+	}
+
+	updated, err := h.repo.Update(ctx, g)
+	if err != nil {
+		if !errors.Is(err, persistence.ErrOptimisticLocking) {
+			return nil, fmt.Errorf("failed to update attribute group: %w", err)
+		}
+		return nil, err
+	}
+
+	return updated, nil
+}
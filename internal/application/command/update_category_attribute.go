@@ -5,19 +5,27 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
 )
 
 type UpdateCategoryAttributeCommand struct {
-	ID         string
-	CategoryID string // for validation
-	Version    int
-	Required   bool
-	SortOrder  int
-	Filterable *bool
-	Searchable *bool
-	Enabled    bool
+	ID             string
+	CategoryID     string // for validation
+	Version        int
+	Required       bool
+	SortOrder      int
+	Filterable     *bool
+	Searchable     *bool
+	Enabled        bool
+	GroupID        *string
+	GroupSortOrder int
+	AllowedOptions []string // nil allows all options
+	DefaultValues  []string // nil means no default
 }
 
 type UpdateCategoryAttributeCommandHandler interface {
@@ -25,12 +33,32 @@ type UpdateCategoryAttributeCommandHandler interface {
 }
 
 type updateCategoryAttributeHandler struct {
-	repo categoryattribute.Repository
+	repo         categoryattribute.Repository
+	attrRepo     attribute.Repository
+	refResolver  attribute.ReferenceResolver
+	groupRepo    attributegroup.Repository
+	outbox       outbox.Outbox
+	txManager    persistence.TxManager
+	eventFactory event.Factory
 }
 
-func NewUpdateCategoryAttributeHandler(repo categoryattribute.Repository) UpdateCategoryAttributeCommandHandler {
+func NewUpdateCategoryAttributeHandler(
+	repo categoryattribute.Repository,
+	attrRepo attribute.Repository,
+	refResolver attribute.ReferenceResolver,
+	groupRepo attributegroup.Repository,
+	outbox outbox.Outbox,
+	txManager persistence.TxManager,
+	eventFactory event.Factory,
+) UpdateCategoryAttributeCommandHandler {
 	return &updateCategoryAttributeHandler{
-		repo: repo,
+		repo:         repo,
+		attrRepo:     attrRepo,
+		refResolver:  refResolver,
+		groupRepo:    groupRepo,
+		outbox:       outbox,
+		txManager:    txManager,
+		eventFactory: eventFactory,
 	}
 }
 
@@ -52,23 +80,53 @@ func (h *updateCategoryAttributeHandler) Handle(ctx context.Context, cmd UpdateC
 		return nil, persistence.ErrOptimisticLocking
 	}
 
+	if err := checkGroupExists(ctx, h.groupRepo, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	defaultValues, err := checkAttributeValues(ctx, h.attrRepo, h.refResolver, ca.AttributeID, cmd.AllowedOptions, cmd.DefaultValues)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := ca.Update(
 		cmd.Required,
 		cmd.SortOrder,
 		cmd.Filterable,
 		cmd.Searchable,
 		cmd.Enabled,
+		cmd.GroupID,
+		cmd.GroupSortOrder,
+		cmd.AllowedOptions,
+		defaultValues,
 	); err != nil {
 		return nil, fmt.Errorf("failed to update category attribute: %w", err)
 	}
 
-	updated, err := h.repo.Update(ctx, ca)
-	if err != nil {
-		if !errors.Is(err, persistence.ErrOptimisticLocking) {
-			return nil, fmt.Errorf("failed to update category attribute: %w", err)
+	var updated *categoryattribute.CategoryAttribute
+	var send outbox.SendFunc
+	_, err = h.txManager.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		result, err := h.repo.Update(txCtx, ca)
+		if err != nil {
+			if !errors.Is(err, persistence.ErrOptimisticLocking) {
+				return nil, fmt.Errorf("failed to update category attribute: %w", err)
+			}
+			return nil, err
+		}
+
+		sendFunc, err := h.outbox.Create(txCtx, h.eventFactory.NewCategoryAttributeUpdatedOutboxMessage(txCtx, result))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox message: %w", err)
 		}
+		updated, send = result, sendFunc
+
+		return nil, nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	sendOutboxMessages(ctx, send)
+
 	return updated, nil
 }
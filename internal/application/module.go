@@ -13,15 +13,36 @@ func Module() fx.Option {
 		fx.Provide(
 			command.NewCreateAttributeHandler,
 			command.NewUpdateAttributeHandler,
+			command.NewRenameAttributeOptionHandler,
+			command.NewMergeAttributeOptionsHandler,
+			command.NewChangeAttributeStatusHandler,
+			command.NewDeleteAttributeHandler,
 			command.NewAssignAttributeToCategoryHandler,
 			command.NewUpdateCategoryAttributeHandler,
 			command.NewUnassignAttributeFromCategoryHandler,
+			command.NewSetCategoryAttributesHandler,
+			command.NewCopyCategoryAttributesHandler,
+			command.NewRemoveCategoryAssignmentsHandler,
+			command.NewDisableCategoryAssignmentsHandler,
+			command.NewCreateAttributeGroupHandler,
+			command.NewUpdateAttributeGroupHandler,
+			command.NewDeleteAttributeGroupHandler,
+			command.NewSyncCategoryParentHandler,
+			command.NewExcludeInheritedAttributeHandler,
+			command.NewIncludeInheritedAttributeHandler,
 		),
 		// Query handlers
 		fx.Provide(
+			query.NewLocalizationConfig,
 			query.NewGetAttributeByIDHandler,
+			query.NewGetAttributeBySlugHandler,
 			query.NewGetAttributeListHandler,
 			query.NewGetCategoryAttributeListHandler,
+			query.NewGetCategorySchemaHandler,
+			query.NewValidateProductAttributesHandler,
+			query.NewGetAttributeGroupByIDHandler,
+			query.NewGetAttributeGroupListHandler,
+			query.NewGetEffectiveCategoryAttributesHandler,
 		),
 	)
 }
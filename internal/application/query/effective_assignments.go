@@ -0,0 +1,83 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+)
+
+// assignmentResolver resolves category assignments including those inherited from ancestor categories
+type assignmentResolver struct {
+	caRepo   categoryattribute.Repository
+	treeRepo categorytree.Repository
+}
+
+func newAssignmentResolver(caRepo categoryattribute.Repository, treeRepo categorytree.Repository) assignmentResolver {
+	return assignmentResolver{
+		caRepo:   caRepo,
+		treeRepo: treeRepo,
+	}
+}
+
+// resolve returns the effective assignments of a category, see effectiveAssignments
+func (r assignmentResolver) resolve(ctx context.Context, categoryID string) ([]*categoryattribute.CategoryAttribute, error) {
+	path, err := r.treeRepo.FindPath(ctx, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category ancestors: %w", err)
+	}
+
+	// A category without a node has no known parent
+	categoryIDs := []string{categoryID}
+	if len(path) > 0 {
+		categoryIDs = lo.Map(path, func(n *categorytree.Node, _ int) string {
+			return n.ID
+		})
+	}
+
+	assignments, err := r.caRepo.FindAllByCategoryIDs(ctx, categoryIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category attributes: %w", err)
+	}
+
+	return effectiveAssignments(categoryIDs, path, assignments), nil
+}
+
+// effectiveAssignments picks the assignments in effect for the first of categoryIDs, which
+// lists the category and its ancestors, nearest first; path holds their nodes when known.
+// The nearest definition of an attribute wins, so a category overrides an inherited
+// assignment by assigning the attribute itself. An exclusion hides the
+// attribute inherited from above the excluding category.
+func effectiveAssignments(
+	categoryIDs []string,
+	path []*categorytree.Node,
+	assignments []*categoryattribute.CategoryAttribute,
+) []*categoryattribute.CategoryAttribute {
+	byCategory := lo.GroupBy(assignments, func(ca *categoryattribute.CategoryAttribute) string {
+		return ca.CategoryID
+	})
+
+	var effective []*categoryattribute.CategoryAttribute
+	resolved := make(map[string]bool)
+	excluded := make(map[string]bool)
+	for i, id := range categoryIDs {
+		for _, ca := range byCategory[id] {
+			if resolved[ca.AttributeID] || excluded[ca.AttributeID] {
+				continue
+			}
+			resolved[ca.AttributeID] = true
+			effective = append(effective, ca)
+		}
+
+		if i < len(path) {
+			for _, attributeID := range path[i].ExcludedAttributeIDs {
+				excluded[attributeID] = true
+			}
+		}
+	}
+
+	return effective
+}
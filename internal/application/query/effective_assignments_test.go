@@ -0,0 +1,92 @@
+package query
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+)
+
+func TestEffectiveAssignments(t *testing.T) {
+	node := func(id string, excluded ...string) *categorytree.Node {
+		return &categorytree.Node{ID: id, ExcludedAttributeIDs: excluded}
+	}
+	assignment := func(categoryID, attributeID string) *categoryattribute.CategoryAttribute {
+		return &categoryattribute.CategoryAttribute{ID: categoryID + "/" + attributeID, CategoryID: categoryID, AttributeID: attributeID}
+	}
+
+	tests := []struct {
+		name        string
+		categoryIDs []string
+		path        []*categorytree.Node
+		assignments []*categoryattribute.CategoryAttribute
+		want        []string // IDs of the effective assignments
+	}{
+		{
+			name:        "own and inherited assignments",
+			categoryIDs: []string{"phones", "electronics", "root"},
+			path:        []*categorytree.Node{node("phones"), node("electronics"), node("root")},
+			assignments: []*categoryattribute.CategoryAttribute{
+				assignment("root", "brand"), assignment("electronics", "warranty"), assignment("phones", "screen"),
+			},
+			want: []string{"phones/screen", "electronics/warranty", "root/brand"},
+		},
+		{
+			name:        "nearest assignment wins",
+			categoryIDs: []string{"phones", "electronics", "root"},
+			path:        []*categorytree.Node{node("phones"), node("electronics"), node("root")},
+			assignments: []*categoryattribute.CategoryAttribute{
+				assignment("root", "brand"), assignment("electronics", "brand"), assignment("phones", "brand"),
+			},
+			want: []string{"phones/brand"},
+		},
+		{
+			name:        "exclusion hides assignments from above",
+			categoryIDs: []string{"phones", "electronics", "root"},
+			path:        []*categorytree.Node{node("phones"), node("electronics", "brand"), node("root")},
+			assignments: []*categoryattribute.CategoryAttribute{
+				assignment("root", "brand"), assignment("root", "color"),
+			},
+			want: []string{"root/color"},
+		},
+		{
+			name:        "exclusion keeps the own assignment of the excluding category",
+			categoryIDs: []string{"phones", "electronics", "root"},
+			path:        []*categorytree.Node{node("phones"), node("electronics", "brand"), node("root")},
+			assignments: []*categoryattribute.CategoryAttribute{
+				assignment("root", "brand"), assignment("electronics", "brand"),
+			},
+			want: []string{"electronics/brand"},
+		},
+		{
+			name:        "category below an exclusion can assign the attribute again",
+			categoryIDs: []string{"phones", "electronics", "root"},
+			path:        []*categorytree.Node{node("phones", "brand"), node("electronics"), node("root")},
+			assignments: []*categoryattribute.CategoryAttribute{
+				assignment("root", "brand"), assignment("phones", "brand"),
+			},
+			want: []string{"phones/brand"},
+		},
+		{
+			name:        "category without a node",
+			categoryIDs: []string{"phones"},
+			assignments: []*categoryattribute.CategoryAttribute{assignment("phones", "screen")},
+			want:        []string{"phones/screen"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			effective := effectiveAssignments(tt.categoryIDs, tt.path, tt.assignments)
+			got := lo.Map(effective, func(ca *categoryattribute.CategoryAttribute, _ int) string {
+				return ca.ID
+			})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("effectiveAssignments() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
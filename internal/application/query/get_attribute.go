@@ -10,7 +10,8 @@ import (
 )
 
 type GetAttributeByIDQuery struct {
-	ID string
+	ID             string
+	AcceptLanguage string // optional, selects translations of attribute texts
 }
 
 type GetAttributeByIDQueryHandler interface {
@@ -18,11 +19,12 @@ type GetAttributeByIDQueryHandler interface {
 }
 
 type getAttributeByIDHandler struct {
-	repo attribute.Repository
+	repo         attribute.Repository
+	localization LocalizationConfig
 }
 
-func NewGetAttributeByIDHandler(repo attribute.Repository) GetAttributeByIDQueryHandler {
-	return &getAttributeByIDHandler{repo: repo}
+func NewGetAttributeByIDHandler(repo attribute.Repository, localization LocalizationConfig) GetAttributeByIDQueryHandler {
+	return &getAttributeByIDHandler{repo: repo, localization: localization}
 }
 
 func (h *getAttributeByIDHandler) Handle(ctx context.Context, query GetAttributeByIDQuery) (*attribute.Attribute, error) {
@@ -33,5 +35,5 @@ func (h *getAttributeByIDHandler) Handle(ctx context.Context, query GetAttribute
 		}
 		return nil, fmt.Errorf("failed to get attribute: %w", err)
 	}
-	return a, nil
+	return a.Localized(h.localization.preferredLocales(query.AcceptLanguage)), nil
 }
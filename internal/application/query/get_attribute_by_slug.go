@@ -0,0 +1,56 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+type GetAttributeBySlugQuery struct {
+	Slug           string
+	AcceptLanguage string // optional, selects translations of attribute texts
+}
+
+type GetAttributeBySlugResult struct {
+	Attribute *attribute.Attribute
+	// Redirected is set when the slug is a former slug of the attribute;
+	// clients should switch to Attribute.Slug
+	Redirected bool
+}
+
+type GetAttributeBySlugQueryHandler interface {
+	Handle(ctx context.Context, query GetAttributeBySlugQuery) (*GetAttributeBySlugResult, error)
+}
+
+type getAttributeBySlugHandler struct {
+	repo         attribute.Repository
+	localization LocalizationConfig
+}
+
+func NewGetAttributeBySlugHandler(repo attribute.Repository, localization LocalizationConfig) GetAttributeBySlugQueryHandler {
+	return &getAttributeBySlugHandler{repo: repo, localization: localization}
+}
+
+func (h *getAttributeBySlugHandler) Handle(ctx context.Context, query GetAttributeBySlugQuery) (*GetAttributeBySlugResult, error) {
+	// A slug in current use wins over a former slug of another attribute
+	locales := h.localization.preferredLocales(query.AcceptLanguage)
+	a, err := h.repo.FindBySlug(ctx, query.Slug)
+	if err == nil {
+		return &GetAttributeBySlugResult{Attribute: a.Localized(locales)}, nil
+	}
+	if !errors.Is(err, persistence.ErrEntityNotFound) {
+		return nil, fmt.Errorf("failed to get attribute: %w", err)
+	}
+
+	a, err = h.repo.FindByPreviousSlug(ctx, query.Slug)
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get attribute: %w", err)
+	}
+	return &GetAttributeBySlugResult{Attribute: a.Localized(locales), Redirected: true}, nil
+}
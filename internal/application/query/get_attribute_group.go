@@ -0,0 +1,37 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+type GetAttributeGroupByIDQuery struct {
+	ID string
+}
+
+type GetAttributeGroupByIDQueryHandler interface {
+	Handle(ctx context.Context, query GetAttributeGroupByIDQuery) (*attributegroup.AttributeGroup, error)
+}
+
+type getAttributeGroupByIDHandler struct {
+	repo attributegroup.Repository
+}
+
+func NewGetAttributeGroupByIDHandler(repo attributegroup.Repository) GetAttributeGroupByIDQueryHandler {
+	return &getAttributeGroupByIDHandler{repo: repo}
+}
+
+func (h *getAttributeGroupByIDHandler) Handle(ctx context.Context, query GetAttributeGroupByIDQuery) (*attributegroup.AttributeGroup, error) {
+	g, err := h.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get attribute group: %w", err)
+	}
+	return g, nil
+}
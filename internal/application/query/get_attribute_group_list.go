@@ -0,0 +1,55 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
+)
+
+type GetAttributeGroupListQuery struct {
+	Page  int
+	Size  int
+	Sort  string
+	Order string
+}
+
+type ListAttributeGroupsResult struct {
+	Items []*attributegroup.AttributeGroup
+	Page  int
+	Size  int
+	Total int64
+}
+
+type GetAttributeGroupListQueryHandler interface {
+	Handle(ctx context.Context, query GetAttributeGroupListQuery) (*ListAttributeGroupsResult, error)
+}
+
+type getAttributeGroupListHandler struct {
+	repo attributegroup.Repository
+}
+
+func NewGetAttributeGroupListHandler(repo attributegroup.Repository) GetAttributeGroupListQueryHandler {
+	return &getAttributeGroupListHandler{repo: repo}
+}
+
+func (h *getAttributeGroupListHandler) Handle(ctx context.Context, query GetAttributeGroupListQuery) (*ListAttributeGroupsResult, error) {
+	listQuery := attributegroup.ListQuery{
+		Page:  query.Page,
+		Size:  query.Size,
+		Sort:  query.Sort,
+		Order: query.Order,
+	}
+
+	result, err := h.repo.FindList(ctx, listQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attribute groups list: %w", err)
+	}
+
+	return &ListAttributeGroupsResult{
+		Items: result.Items,
+		Page:  result.Page,
+		Size:  result.Size,
+		Total: result.Total,
+	}, nil
+}
@@ -4,16 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/samber/lo"
+
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
 )
 
 type GetAttributeListQuery struct {
-	Page    int
-	Size    int
-	Enabled *bool
-	Type    *string
-	Sort    string
-	Order   string
+	Page           int
+	Size           int
+	Enabled        *bool
+	Type           *string
+	Status         *string // nil lists all but archived attributes
+	Sort           string
+	Order          string
+	AcceptLanguage string // optional, selects translations of attribute texts
 }
 
 type ListAttributesResult struct {
@@ -28,11 +32,12 @@ type GetAttributeListQueryHandler interface {
 }
 
 type getAttributeListHandler struct {
-	repo attribute.Repository
+	repo         attribute.Repository
+	localization LocalizationConfig
 }
 
-func NewGetAttributeListHandler(repo attribute.Repository) GetAttributeListQueryHandler {
-	return &getAttributeListHandler{repo: repo}
+func NewGetAttributeListHandler(repo attribute.Repository, localization LocalizationConfig) GetAttributeListQueryHandler {
+	return &getAttributeListHandler{repo: repo, localization: localization}
 }
 
 func (h *getAttributeListHandler) Handle(ctx context.Context, query GetAttributeListQuery) (*ListAttributesResult, error) {
@@ -41,6 +46,7 @@ func (h *getAttributeListHandler) Handle(ctx context.Context, query GetAttribute
 		Size:    query.Size,
 		Enabled: query.Enabled,
 		Type:    query.Type,
+		Status:  query.Status,
 		Sort:    query.Sort,
 		Order:   query.Order,
 	}
@@ -50,8 +56,12 @@ func (h *getAttributeListHandler) Handle(ctx context.Context, query GetAttribute
 		return nil, fmt.Errorf("failed to get attributes list: %w", err)
 	}
 
+	locales := h.localization.preferredLocales(query.AcceptLanguage)
+
 	return &ListAttributesResult{
-		Items: result.Items,
+		Items: lo.Map(result.Items, func(a *attribute.Attribute, _ int) *attribute.Attribute {
+			return a.Localized(locales)
+		}),
 		Page:  result.Page,
 		Size:  result.Size,
 		Total: result.Total,
@@ -3,7 +3,13 @@ package query
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
 )
 
@@ -12,16 +18,44 @@ type GetCategoryAttributeListQuery struct {
 	Page       int
 	Size       int
 	Enabled    *bool
-	Filterable *bool
+	Filterable *bool // effective value
 	Sort       string
 	Order      string
 }
 
+// CategoryAttributeView is a category assignment with its flags resolved against attribute defaults
+type CategoryAttributeView struct {
+	CategoryAttribute *categoryattribute.CategoryAttribute
+	Filterable        bool // effective value
+	Searchable        bool // effective value
+}
+
+// NewCategoryAttributeView resolves effective flags; a nil attribute falls back to disabled defaults
+func NewCategoryAttributeView(ca *categoryattribute.CategoryAttribute, a *attribute.Attribute) CategoryAttributeView {
+	var filterableDefault, searchableDefault bool
+	if a != nil {
+		filterableDefault, searchableDefault = a.Filterable, a.Searchable
+	}
+
+	return CategoryAttributeView{
+		CategoryAttribute: ca,
+		Filterable:        ca.EffectiveFilterable(filterableDefault),
+		Searchable:        ca.EffectiveSearchable(searchableDefault),
+	}
+}
+
+// CategoryAttributeGroupView is a group of category attributes ordered by GroupSortOrder
+type CategoryAttributeGroupView struct {
+	Group *attributegroup.AttributeGroup // nil for ungrouped attributes
+	Items []CategoryAttributeView
+}
+
 type ListCategoryAttributesResult struct {
-	Items []*categoryattribute.CategoryAttribute
-	Page  int
-	Size  int
-	Total int64
+	Items  []CategoryAttributeView
+	Groups []CategoryAttributeGroupView // Items of the page grouped by attribute group
+	Page   int
+	Size   int
+	Total  int64
 }
 
 type GetCategoryAttributeListQueryHandler interface {
@@ -29,11 +63,21 @@ type GetCategoryAttributeListQueryHandler interface {
 }
 
 type getCategoryAttributeListHandler struct {
-	repo categoryattribute.Repository
+	repo      categoryattribute.Repository
+	attrRepo  attribute.Repository
+	groupRepo attributegroup.Repository
 }
 
-func NewGetCategoryAttributeListHandler(repo categoryattribute.Repository) GetCategoryAttributeListQueryHandler {
-	return &getCategoryAttributeListHandler{repo: repo}
+func NewGetCategoryAttributeListHandler(
+	repo categoryattribute.Repository,
+	attrRepo attribute.Repository,
+	groupRepo attributegroup.Repository,
+) GetCategoryAttributeListQueryHandler {
+	return &getCategoryAttributeListHandler{
+		repo:      repo,
+		attrRepo:  attrRepo,
+		groupRepo: groupRepo,
+	}
 }
 
 func (h *getCategoryAttributeListHandler) Handle(ctx context.Context, query GetCategoryAttributeListQuery) (*ListCategoryAttributesResult, error) {
@@ -47,15 +91,123 @@ func (h *getCategoryAttributeListHandler) Handle(ctx context.Context, query GetC
 		Order:      query.Order,
 	}
 
+	if query.Filterable != nil {
+		ids, err := h.findFilterableByDefault(ctx, query.CategoryID, *query.Filterable)
+		if err != nil {
+			return nil, err
+		}
+		listQuery.FilterableByDefault = ids
+	}
+
 	result, err := h.repo.FindList(ctx, listQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get category attributes list: %w", err)
 	}
 
+	attributes, err := h.attrRepo.FindByIDs(ctx, lo.Map(result.Items, func(ca *categoryattribute.CategoryAttribute, _ int) string {
+		return ca.AttributeID
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+
+	attributesByID := lo.KeyBy(attributes, func(a *attribute.Attribute) string {
+		return a.ID
+	})
+
+	items := lo.Map(result.Items, func(ca *categoryattribute.CategoryAttribute, _ int) CategoryAttributeView {
+		return NewCategoryAttributeView(ca, attributesByID[ca.AttributeID])
+	})
+
+	groups, err := h.groupItems(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ListCategoryAttributesResult{
-		Items: result.Items,
-		Page:  result.Page,
-		Size:  result.Size,
-		Total: result.Total,
+		Items:  items,
+		Groups: groups,
+		Page:   result.Page,
+		Size:   result.Size,
+		Total:  result.Total,
 	}, nil
 }
+
+// groupItems groups items by attribute group; groups follow their SortOrder and ungrouped items come last
+func (h *getCategoryAttributeListHandler) groupItems(ctx context.Context, items []CategoryAttributeView) ([]CategoryAttributeGroupView, error) {
+	groupIDs := lo.Uniq(lo.FilterMap(items, func(item CategoryAttributeView, _ int) (string, bool) {
+		return lo.FromPtr(item.CategoryAttribute.GroupID), item.CategoryAttribute.GroupID != nil
+	}))
+
+	groups, err := h.groupRepo.FindByIDs(ctx, groupIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attribute groups: %w", err)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].SortOrder < groups[j].SortOrder
+	})
+
+	itemsByGroup := lo.GroupBy(items, func(item CategoryAttributeView) string {
+		return lo.FromPtr(item.CategoryAttribute.GroupID)
+	})
+
+	views := make([]CategoryAttributeGroupView, 0, len(groups)+1)
+	for _, g := range groups {
+		views = append(views, CategoryAttributeGroupView{
+			Group: g,
+			Items: sortByGroupOrder(itemsByGroup[g.ID]),
+		})
+		delete(itemsByGroup, g.ID)
+	}
+
+	// Items without a group or referencing a missing one
+	var ungrouped []CategoryAttributeView
+	for _, item := range items {
+		if _, ok := itemsByGroup[lo.FromPtr(item.CategoryAttribute.GroupID)]; ok {
+			ungrouped = append(ungrouped, item)
+		}
+	}
+	if len(ungrouped) > 0 {
+		views = append(views, CategoryAttributeGroupView{
+			Items: sortByGroupOrder(ungrouped),
+		})
+	}
+
+	return views, nil
+}
+
+func sortByGroupOrder(items []CategoryAttributeView) []CategoryAttributeView {
+	sorted := slices.Clone(items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].CategoryAttribute, sorted[j].CategoryAttribute
+		if a.GroupSortOrder != b.GroupSortOrder {
+			return a.GroupSortOrder < b.GroupSortOrder
+		}
+		return a.SortOrder < b.SortOrder
+	})
+	return sorted
+}
+
+// findFilterableByDefault returns IDs of the category's attributes whose default filterable flag equals value
+func (h *getCategoryAttributeListHandler) findFilterableByDefault(ctx context.Context, categoryID string, value bool) ([]string, error) {
+	assignments, err := h.repo.FindAllByCategoryID(ctx, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category attributes: %w", err)
+	}
+
+	attributes, err := h.attrRepo.FindByIDs(ctx, lo.Map(assignments, func(ca *categoryattribute.CategoryAttribute, _ int) string {
+		return ca.AttributeID
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+
+	ids := make([]string, 0, len(attributes))
+	for _, a := range attributes {
+		if a.Filterable == value {
+			ids = append(ids, a.ID)
+		}
+	}
+	return ids, nil
+}
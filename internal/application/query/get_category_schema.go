@@ -0,0 +1,109 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+)
+
+type GetCategorySchemaQuery struct {
+	CategoryID     string
+	AcceptLanguage string // optional, selects translations of attribute texts
+}
+
+// CategorySchemaAttribute is an attribute definition merged with its category assignment
+type CategorySchemaAttribute struct {
+	Attribute  *attribute.Attribute
+	Options    []attribute.Option // enabled options allowed in the category, sorted by SortOrder
+	Assignment *categoryattribute.CategoryAttribute
+	Filterable bool // effective value
+	Searchable bool // effective value
+}
+
+// CategorySchema lists the enabled attributes of a category, including inherited
+// ones, ordered by assignment SortOrder
+type CategorySchema struct {
+	CategoryID string
+	Attributes []CategorySchemaAttribute
+}
+
+type GetCategorySchemaQueryHandler interface {
+	Handle(ctx context.Context, query GetCategorySchemaQuery) (*CategorySchema, error)
+}
+
+type getCategorySchemaHandler struct {
+	resolver     assignmentResolver
+	attrRepo     attribute.Repository
+	localization LocalizationConfig
+}
+
+func NewGetCategorySchemaHandler(
+	caRepo categoryattribute.Repository,
+	treeRepo categorytree.Repository,
+	attrRepo attribute.Repository,
+	localization LocalizationConfig,
+) GetCategorySchemaQueryHandler {
+	return &getCategorySchemaHandler{
+		resolver:     newAssignmentResolver(caRepo, treeRepo),
+		attrRepo:     attrRepo,
+		localization: localization,
+	}
+}
+
+func (h *getCategorySchemaHandler) Handle(ctx context.Context, query GetCategorySchemaQuery) (*CategorySchema, error) {
+	assignments, err := h.resolver.resolve(ctx, query.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments = lo.Filter(assignments, func(ca *categoryattribute.CategoryAttribute, _ int) bool {
+		return ca.IsActive()
+	})
+
+	attributes, err := h.attrRepo.FindByIDs(ctx, lo.Map(assignments, func(ca *categoryattribute.CategoryAttribute, _ int) string {
+		return ca.AttributeID
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+
+	attributesByID := lo.KeyBy(attributes, func(a *attribute.Attribute) string {
+		return a.ID
+	})
+
+	sort.SliceStable(assignments, func(i, j int) bool {
+		return assignments[i].SortOrder < assignments[j].SortOrder
+	})
+
+	locales := h.localization.preferredLocales(query.AcceptLanguage)
+
+	items := make([]CategorySchemaAttribute, 0, len(assignments))
+	for _, ca := range assignments {
+		a, ok := attributesByID[ca.AttributeID]
+		if !ok || !a.Enabled {
+			continue
+		}
+		a = a.Localized(locales)
+
+		items = append(items, CategorySchemaAttribute{
+			Attribute: a,
+			Options: lo.Filter(a.EnabledOptions(), func(opt attribute.Option, _ int) bool {
+				return ca.AllowsOption(opt.Slug)
+			}),
+			Assignment: ca,
+			Filterable: ca.EffectiveFilterable(a.Filterable),
+			Searchable: ca.EffectiveSearchable(a.Searchable),
+		})
+	}
+
+	return &CategorySchema{
+		CategoryID: query.CategoryID,
+		Attributes: items,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+type GetEffectiveCategoryAttributesQuery struct {
+	CategoryID string
+}
+
+// EffectiveCategoryAttribute is an assignment that applies to a category, either its own or inherited
+type EffectiveCategoryAttribute struct {
+	CategoryAttributeView
+	Inherited bool // CategoryAttribute.CategoryID is the ancestor the assignment comes from
+}
+
+type EffectiveCategoryAttributesResult struct {
+	CategoryID           string
+	Items                []EffectiveCategoryAttribute // ordered by SortOrder
+	ExcludedAttributeIDs []string                     // exclusions made by the category itself
+}
+
+type GetEffectiveCategoryAttributesQueryHandler interface {
+	Handle(ctx context.Context, query GetEffectiveCategoryAttributesQuery) (*EffectiveCategoryAttributesResult, error)
+}
+
+type getEffectiveCategoryAttributesHandler struct {
+	resolver assignmentResolver
+	treeRepo categorytree.Repository
+	attrRepo attribute.Repository
+}
+
+func NewGetEffectiveCategoryAttributesHandler(
+	caRepo categoryattribute.Repository,
+	treeRepo categorytree.Repository,
+	attrRepo attribute.Repository,
+) GetEffectiveCategoryAttributesQueryHandler {
+	return &getEffectiveCategoryAttributesHandler{
+		resolver: newAssignmentResolver(caRepo, treeRepo),
+		treeRepo: treeRepo,
+		attrRepo: attrRepo,
+	}
+}
+
+func (h *getEffectiveCategoryAttributesHandler) Handle(ctx context.Context, query GetEffectiveCategoryAttributesQuery) (*EffectiveCategoryAttributesResult, error) {
+	assignments, err := h.resolver.resolve(ctx, query.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := h.attrRepo.FindByIDs(ctx, lo.Map(assignments, func(ca *categoryattribute.CategoryAttribute, _ int) string {
+		return ca.AttributeID
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+
+	attributesByID := lo.KeyBy(attributes, func(a *attribute.Attribute) string {
+		return a.ID
+	})
+
+	items := lo.Map(assignments, func(ca *categoryattribute.CategoryAttribute, _ int) EffectiveCategoryAttribute {
+		return EffectiveCategoryAttribute{
+			CategoryAttributeView: NewCategoryAttributeView(ca, attributesByID[ca.AttributeID]),
+			Inherited:             ca.CategoryID != query.CategoryID,
+		}
+	})
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].CategoryAttribute.SortOrder < items[j].CategoryAttribute.SortOrder
+	})
+
+	result := &EffectiveCategoryAttributesResult{
+		CategoryID: query.CategoryID,
+		Items:      items,
+	}
+
+	node, err := h.treeRepo.FindByID(ctx, query.CategoryID)
+	if err != nil && !errors.Is(err, persistence.ErrEntityNotFound) {
+		return nil, fmt.Errorf("failed to get category node: %w", err)
+	}
+	if node != nil {
+		result.ExcludedAttributeIDs = node.ExcludedAttributeIDs
+	}
+
+	return result, nil
+}
@@ -0,0 +1,61 @@
+package query
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+	"golang.org/x/text/language"
+)
+
+const defaultLocale = "en"
+
+// LocalizationConfig configures localized read paths
+type LocalizationConfig struct {
+	// DefaultLocale is the locale of untranslated attribute and option texts
+	DefaultLocale string `mapstructure:"default-locale"`
+}
+
+func NewLocalizationConfig(v *viper.Viper) (LocalizationConfig, error) {
+	cfg := LocalizationConfig{}
+
+	if sub := v.Sub("localization"); sub != nil {
+		if err := sub.Unmarshal(&cfg); err != nil {
+			return cfg, fmt.Errorf("failed to load localization config: %w", err)
+		}
+	}
+
+	if cfg.DefaultLocale == "" {
+		cfg.DefaultLocale = defaultLocale
+	}
+
+	return cfg, nil
+}
+
+// preferredLocales turns an Accept-Language header into the translation lookup order.
+// The list stops at the default locale, whose texts are stored untranslated.
+func (c LocalizationConfig) preferredLocales(acceptLanguage string) []string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return nil
+	}
+
+	defaultBase := baseLanguage(language.Make(c.DefaultLocale))
+
+	var locales []string
+	for _, tag := range tags {
+		base := baseLanguage(tag)
+		if base == defaultBase {
+			break
+		}
+		locales = append(locales, tag.String())
+		if base != tag.String() {
+			locales = append(locales, base)
+		}
+	}
+	return locales
+}
+
+func baseLanguage(tag language.Tag) string {
+	base, _ := tag.Base()
+	return base.String()
+}
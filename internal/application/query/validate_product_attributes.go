@@ -0,0 +1,147 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+)
+
+type ValidateProductAttributesQuery struct {
+	CategoryID string
+	Values     map[string][]string // attribute slug -> values
+}
+
+// AttributeValueError is a rejected value of a single product attribute
+type AttributeValueError struct {
+	Attribute string // attribute slug
+	Code      attribute.ValueErrorCode
+	Value     *string
+	Message   string
+}
+
+type ProductAttributesValidationResult struct {
+	Valid  bool
+	Errors []AttributeValueError
+}
+
+type ValidateProductAttributesQueryHandler interface {
+	Handle(ctx context.Context, query ValidateProductAttributesQuery) (*ProductAttributesValidationResult, error)
+}
+
+type validateProductAttributesHandler struct {
+	resolver    assignmentResolver
+	attrRepo    attribute.Repository
+	refResolver attribute.ReferenceResolver
+}
+
+func NewValidateProductAttributesHandler(
+	caRepo categoryattribute.Repository,
+	treeRepo categorytree.Repository,
+	attrRepo attribute.Repository,
+	refResolver attribute.ReferenceResolver,
+) ValidateProductAttributesQueryHandler {
+	return &validateProductAttributesHandler{
+		resolver:    newAssignmentResolver(caRepo, treeRepo),
+		attrRepo:    attrRepo,
+		refResolver: refResolver,
+	}
+}
+
+func (h *validateProductAttributesHandler) Handle(ctx context.Context, query ValidateProductAttributesQuery) (*ProductAttributesValidationResult, error) {
+	assignments, err := h.resolver.resolve(ctx, query.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := h.attrRepo.FindByIDs(ctx, lo.Map(assignments, func(ca *categoryattribute.CategoryAttribute, _ int) string {
+		return ca.AttributeID
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+
+	attributesByID := lo.KeyBy(attributes, func(a *attribute.Attribute) string {
+		return a.ID
+	})
+
+	var errs []AttributeValueError
+	known := make(map[string]bool, len(assignments))
+	for _, ca := range assignments {
+		a, ok := attributesByID[ca.AttributeID]
+		if !ok {
+			continue
+		}
+		known[a.Slug] = true
+
+		values := query.Values[a.Slug]
+		if !a.Enabled || !ca.IsActive() {
+			// disabled attributes take no values, whether they are required or not
+			if len(values) > 0 {
+				errs = append(errs, AttributeValueError{
+					Attribute: a.Slug,
+					Code:      attribute.ValueErrorDisabledAttribute,
+					Message:   "attribute is disabled",
+				})
+			}
+			continue
+		}
+
+		if len(values) == 0 {
+			if ca.Required {
+				errs = append(errs, AttributeValueError{
+					Attribute: a.Slug,
+					Code:      attribute.ValueErrorRequired,
+					Message:   "value is required",
+				})
+			}
+			continue
+		}
+
+		valueErrs := a.ValidateValues(values, ca.AllowedOptions)
+		if len(valueErrs) == 0 {
+			// only well-formed values are looked up in the owning services
+			valueErrs, err = a.ResolveReferences(ctx, h.refResolver, values)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, valueErr := range valueErrs {
+			errs = append(errs, toAttributeValueError(a.Slug, valueErr))
+		}
+	}
+
+	for slug := range query.Values {
+		if !known[slug] {
+			errs = append(errs, AttributeValueError{
+				Attribute: slug,
+				Code:      attribute.ValueErrorUnknownAttribute,
+				Message:   "attribute is not assigned to the category",
+			})
+		}
+	}
+
+	// Map iteration order is random, keep the response stable
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Attribute < errs[j].Attribute
+	})
+
+	return &ProductAttributesValidationResult{
+		Valid:  len(errs) == 0,
+		Errors: errs,
+	}, nil
+}
+
+func toAttributeValueError(slug string, err attribute.ValueError) AttributeValueError {
+	return AttributeValueError{
+		Attribute: slug,
+		Code:      err.Code,
+		Value:     err.Value,
+		Message:   err.Message,
+	}
+}
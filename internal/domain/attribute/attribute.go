@@ -2,83 +2,179 @@ package attribute
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 // AttributeType represents the type of attribute
 type AttributeType string
 
 const (
-	AttributeTypeSingle   AttributeType = "single"
-	AttributeTypeMultiple AttributeType = "multiple"
-	AttributeTypeRange    AttributeType = "range"
-	AttributeTypeBoolean  AttributeType = "boolean"
-	AttributeTypeText     AttributeType = "text"
+	AttributeTypeSingle    AttributeType = "single"
+	AttributeTypeMultiple  AttributeType = "multiple"
+	AttributeTypeRange     AttributeType = "range"
+	AttributeTypeBoolean   AttributeType = "boolean"
+	AttributeTypeText      AttributeType = "text"
+	AttributeTypeDate      AttributeType = "date"      // YYYY-MM-DD values
+	AttributeTypeDatetime  AttributeType = "datetime"  // RFC 3339 values
+	AttributeTypeDimension AttributeType = "dimension" // JSON object values, e.g. {"width":120,"height":75}
+	AttributeTypeReference AttributeType = "reference" // IDs of entities owned by other services
 )
 
 // Option represents an attribute option (embedded in Attribute)
 type Option struct {
-	Name      string
-	Slug      string
-	ColorCode *string
-	SortOrder int
-	Enabled   bool
+	Name         string
+	Slug         string
+	ColorCode    *string      // #RGB, #RRGGBB, #RRGGBBAA or rgba()
+	Image        *OptionImage // image swatch
+	SortOrder    int
+	Enabled      bool
+	Translations map[string]string // locale -> option name
+	// PreviousSlugs are retired slugs of renamed and merged options that still resolve to this option
+	PreviousSlugs []string
+}
+
+// Translation holds locale-specific attribute texts
+type Translation struct {
+	Name string
+	Unit *string
+}
+
+// RangeConfig describes bounds of a numeric range attribute
+type RangeConfig struct {
+	Min       float64
+	Max       float64
+	Step      *float64
+	Precision *int // number of decimal places
+}
+
+// DateConfig bounds the values of a date or datetime attribute.
+// Bounds use the value format of the attribute type and are inclusive.
+type DateConfig struct {
+	Min *string
+	Max *string
+}
+
+// DimensionComponent is a named numeric part of a dimension attribute, e.g. width
+type DimensionComponent struct {
+	Key  string
+	Unit *string
+	Min  float64
+	Max  float64
+}
+
+// DimensionConfig lists the components of a dimension attribute in display order
+type DimensionConfig struct {
+	Components []DimensionComponent
+}
+
+// TextConfig constrains the values of a text attribute.
+// Lengths count characters; Pattern is a regular expression that has to match the whole value.
+type TextConfig struct {
+	MinLength *int
+	MaxLength *int
+	Pattern   *string
+	Multiline bool
+}
+
+// ReferenceConfig declares the kind of external entity a reference attribute points to, e.g. brand
+type ReferenceConfig struct {
+	TargetKind string
+}
+
+// TypeConfig holds type-specific configuration (embedded in Attribute)
+type TypeConfig struct {
+	Range      *RangeConfig
+	Date       *DateConfig
+	Dimension  *DimensionConfig
+	Reference  *ReferenceConfig
+	Text       *TextConfig
+	SwatchMode SwatchMode
 }
 
 // Attribute - domain aggregate root
 type Attribute struct {
-	ID         string
-	Version    int
-	Name       string
-	Slug       string
-	Type       AttributeType
-	Unit       *string
-	Enabled    bool
-	Options    []Option
-	CreatedAt  time.Time
-	ModifiedAt time.Time
+	ID            string
+	Version       int
+	Name          string
+	Slug          string
+	PreviousSlugs []string // former slugs, lookups by them redirect to the current slug
+	Status        AttributeStatus
+	Type          AttributeType
+	Unit          *string
+	Enabled       bool
+	Filterable    bool // default for category assignments without an override
+	Searchable    bool // default for category assignments without an override
+	Options       []Option
+	TypeConfig    TypeConfig
+	// Translations are keyed by locale; Name and Unit hold the default locale texts
+	Translations map[string]Translation
+	CreatedAt    time.Time
+	ModifiedAt   time.Time
 }
 
 var slugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
 
+const maxNameLength = 100
+
 // NewAttribute creates a new attribute with validation.
 // If id is empty, a new UUID will be generated.
 func NewAttribute(
 	id string,
 	name string,
 	slug string,
+	status AttributeStatus,
 	attrType AttributeType,
 	unit *string,
 	enabled bool,
+	filterable bool,
+	searchable bool,
 	options []Option,
+	typeConfig TypeConfig,
+	translations map[string]Translation,
 ) (*Attribute, error) {
-	if err := validateAttributeData(name, slug, attrType); err != nil {
+	if err := validateAttributeData(name, slug, attrType, options, typeConfig); err != nil {
 		return nil, err
 	}
 
+	if !isValidInitialStatus(status) {
+		return nil, errors.New("attribute status must be draft or active on creation")
+	}
+
 	if err := validateOptions(options); err != nil {
 		return nil, err
 	}
 
+	if err := validateTranslations(translations); err != nil {
+		return nil, err
+	}
+
 	if id == "" {
 		id = uuid.New().String()
 	}
 
 	now := time.Now().UTC()
 	return &Attribute{
-		ID:         id,
-		Version:    1,
-		Name:       name,
-		Slug:       slug,
-		Type:       attrType,
-		Unit:       unit,
-		Enabled:    enabled,
-		Options:    options,
-		CreatedAt:  now,
-		ModifiedAt: now,
+		ID:           id,
+		Version:      1,
+		Name:         name,
+		Slug:         slug,
+		Status:       status,
+		Type:         attrType,
+		Unit:         unit,
+		Enabled:      enabled,
+		Filterable:   filterable,
+		Searchable:   searchable,
+		Options:      options,
+		TypeConfig:   typeConfig,
+		Translations: translations,
+		CreatedAt:    now,
+		ModifiedAt:   now,
 	}, nil
 }
 
@@ -88,24 +184,36 @@ func Reconstruct(
 	version int,
 	name string,
 	slug string,
+	previousSlugs []string,
+	status AttributeStatus,
 	attrType AttributeType,
 	unit *string,
 	enabled bool,
+	filterable bool,
+	searchable bool,
 	options []Option,
+	typeConfig TypeConfig,
+	translations map[string]Translation,
 	createdAt time.Time,
 	modifiedAt time.Time,
 ) *Attribute {
 	return &Attribute{
-		ID:         id,
-		Version:    version,
-		Name:       name,
-		Slug:       slug,
-		Type:       attrType,
-		Unit:       unit,
-		Enabled:    enabled,
-		Options:    options,
-		CreatedAt:  createdAt,
-		ModifiedAt: modifiedAt,
+		ID:            id,
+		Version:       version,
+		Name:          name,
+		Slug:          slug,
+		PreviousSlugs: previousSlugs,
+		Status:        status,
+		Type:          attrType,
+		Unit:          unit,
+		Enabled:       enabled,
+		Filterable:    filterable,
+		Searchable:    searchable,
+		Options:       options,
+		TypeConfig:    typeConfig,
+		Translations:  translations,
+		CreatedAt:     createdAt,
+		ModifiedAt:    modifiedAt,
 	}
 }
 
@@ -116,29 +224,68 @@ func (a *Attribute) Update(
 	attrType AttributeType,
 	unit *string,
 	enabled bool,
+	filterable bool,
+	searchable bool,
 	options []Option,
+	typeConfig TypeConfig,
+	translations map[string]Translation,
 ) error {
-	if err := validateAttributeData(name, slug, attrType); err != nil {
+	if err := validateAttributeData(name, slug, attrType, options, typeConfig); err != nil {
 		return err
 	}
 
+	options = a.withSlugHistory(options)
+
 	if err := validateOptions(options); err != nil {
 		return err
 	}
 
+	if err := validateTranslations(translations); err != nil {
+		return err
+	}
+
+	if slug != a.Slug {
+		// changing back to a former slug takes it out of the history
+		a.PreviousSlugs = append(lo.Without(a.PreviousSlugs, slug), a.Slug)
+	}
+
 	a.Name = name
 	a.Slug = slug
 	a.Type = attrType
 	a.Unit = unit
 	a.Enabled = enabled
+	a.Filterable = filterable
+	a.Searchable = searchable
 	a.Options = options
+	a.TypeConfig = typeConfig
+	a.Translations = translations
 	a.ModifiedAt = time.Now().UTC()
 
 	return nil
 }
 
+// CheckAssignedTypeChange verifies that the attribute may switch to attrType while it is
+// assigned to categories, whose products may already hold values of it
+func (a *Attribute) CheckAssignedTypeChange(attrType AttributeType) error {
+	if !isTypeChangeCompatible(a.Type, attrType) {
+		return ErrIncompatibleTypeChange
+	}
+	return nil
+}
+
+// EnabledOptions returns the enabled options ordered by SortOrder
+func (a *Attribute) EnabledOptions() []Option {
+	options := lo.Filter(a.Options, func(opt Option, _ int) bool {
+		return opt.Enabled
+	})
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].SortOrder < options[j].SortOrder
+	})
+	return options
+}
+
 // validateAttributeData validates business rules
-func validateAttributeData(name string, slug string, attrType AttributeType) error {
+func validateAttributeData(name string, slug string, attrType AttributeType, options []Option, typeConfig TypeConfig) error {
 	if name == "" {
 		return errors.New("name is required")
 	}
@@ -163,12 +310,137 @@ func validateAttributeData(name string, slug string, attrType AttributeType) err
 		return errors.New("invalid attribute type")
 	}
 
+	if err := validateTypeOptions(attrType, options); err != nil {
+		return err
+	}
+
+	if attrType == AttributeTypeRange {
+		if typeConfig.Range == nil {
+			return errors.New("range configuration is required for range attribute")
+		}
+		if err := validateRangeConfig(*typeConfig.Range); err != nil {
+			return err
+		}
+	} else if typeConfig.Range != nil {
+		return errors.New("range configuration is allowed only for range attribute")
+	}
+
+	if err := validateSwatchMode(typeConfig.SwatchMode, attrType, options); err != nil {
+		return err
+	}
+
+	if typeConfig.Date != nil {
+		if attrType != AttributeTypeDate && attrType != AttributeTypeDatetime {
+			return errors.New("date configuration is allowed only for date and datetime attributes")
+		}
+		if err := validateDateConfig(attrType, *typeConfig.Date); err != nil {
+			return err
+		}
+	}
+
+	if attrType == AttributeTypeDimension {
+		if typeConfig.Dimension == nil {
+			return errors.New("dimension configuration is required for dimension attribute")
+		}
+		if err := validateDimensionConfig(*typeConfig.Dimension); err != nil {
+			return err
+		}
+	} else if typeConfig.Dimension != nil {
+		return errors.New("dimension configuration is allowed only for dimension attribute")
+	}
+
+	if attrType == AttributeTypeReference {
+		if typeConfig.Reference == nil {
+			return errors.New("reference configuration is required for reference attribute")
+		}
+		if err := validateReferenceConfig(*typeConfig.Reference); err != nil {
+			return err
+		}
+	} else if typeConfig.Reference != nil {
+		return errors.New("reference configuration is allowed only for reference attribute")
+	}
+
+	if typeConfig.Text != nil {
+		if attrType != AttributeTypeText {
+			return errors.New("text configuration is allowed only for text attribute")
+		}
+		if err := validateTextConfig(*typeConfig.Text); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTypeOptions enforces which attribute types may carry options
+func validateTypeOptions(attrType AttributeType, options []Option) error {
+	switch attrType {
+	case AttributeTypeSingle, AttributeTypeMultiple:
+		if !lo.ContainsBy(options, func(opt Option) bool { return opt.Enabled }) {
+			return fmt.Errorf("%s attribute requires at least one enabled option", attrType)
+		}
+	case AttributeTypeRange, AttributeTypeBoolean, AttributeTypeText, AttributeTypeDate, AttributeTypeDatetime,
+		AttributeTypeDimension, AttributeTypeReference:
+		if len(options) > 0 {
+			return fmt.Errorf("%s attribute cannot have options", attrType)
+		}
+	}
+	return nil
+}
+
+// isTypeChangeCompatible reports whether values stored for an attribute of type from
+// remain valid after switching to type to
+func isTypeChangeCompatible(from AttributeType, to AttributeType) bool {
+	if from == to {
+		return true
+	}
+	// every single value is also a valid one-element multiple value
+	return from == AttributeTypeSingle && to == AttributeTypeMultiple
+}
+
+func validateRangeConfig(cfg RangeConfig) error {
+	if cfg.Min >= cfg.Max {
+		return errors.New("range min must be less than max")
+	}
+
+	if cfg.Step != nil {
+		if *cfg.Step <= 0 {
+			return errors.New("range step must be positive")
+		}
+		if *cfg.Step > cfg.Max-cfg.Min {
+			return errors.New("range step cannot exceed the range width")
+		}
+	}
+
+	if cfg.Precision != nil && (*cfg.Precision < 0 || *cfg.Precision > 10) {
+		return errors.New("range precision must be between 0 and 10")
+	}
+
+	return nil
+}
+
+func validateDateConfig(attrType AttributeType, cfg DateConfig) error {
+	lower, err := cfg.parseBound(attrType, cfg.Min)
+	if err != nil {
+		return fmt.Errorf("date min: %w", err)
+	}
+
+	upper, err := cfg.parseBound(attrType, cfg.Max)
+	if err != nil {
+		return fmt.Errorf("date max: %w", err)
+	}
+
+	if lower != nil && upper != nil && !lower.Before(*upper) {
+		return errors.New("date min must be before max")
+	}
+
 	return nil
 }
 
 func isValidAttributeType(t AttributeType) bool {
 	switch t {
-	case AttributeTypeSingle, AttributeTypeMultiple, AttributeTypeRange, AttributeTypeBoolean, AttributeTypeText:
+	case AttributeTypeSingle, AttributeTypeMultiple, AttributeTypeRange, AttributeTypeBoolean, AttributeTypeText,
+		AttributeTypeDate, AttributeTypeDatetime, AttributeTypeDimension, AttributeTypeReference:
 		return true
 	}
 	return false
@@ -188,14 +460,8 @@ func validateOptions(options []Option) error {
 		if len(opt.Name) > 100 {
 			return errors.New("option name is too long (max 100 characters)")
 		}
-		if opt.Slug == "" {
-			return errors.New("option slug is required")
-		}
-		if len(opt.Slug) > 50 {
-			return errors.New("option slug is too long (max 50 characters)")
-		}
-		if !slugRegex.MatchString(opt.Slug) {
-			return errors.New("option slug must contain only lowercase letters, numbers, and hyphens")
+		if err := validateOptionSlug(opt.Slug); err != nil {
+			return err
 		}
 		if slugs[opt.Slug] {
 			return errors.New("duplicate option slug: " + opt.Slug)
@@ -204,6 +470,44 @@ func validateOptions(options []Option) error {
 		if opt.SortOrder < 0 {
 			return errors.New("option sortOrder cannot be negative")
 		}
+		if opt.ColorCode != nil {
+			if err := validateColorCode(*opt.ColorCode); err != nil {
+				return fmt.Errorf("option %s: %w", opt.Slug, err)
+			}
+		}
+		if opt.Image != nil {
+			if err := validateOptionImage(*opt.Image); err != nil {
+				return fmt.Errorf("option %s: %w", opt.Slug, err)
+			}
+		}
+		for locale, translated := range opt.Translations {
+			if err := validateLocalizedName(locale, translated); err != nil {
+				return fmt.Errorf("option %s: %w", opt.Slug, err)
+			}
+		}
+	}
+
+	// retired slugs keep resolving, so they cannot be reused by another option
+	for _, opt := range options {
+		for _, previous := range opt.PreviousSlugs {
+			if slugs[previous] {
+				return fmt.Errorf("option slug %s: %w", previous, ErrOptionSlugTaken)
+			}
+			slugs[previous] = true
+		}
+	}
+	return nil
+}
+
+func validateOptionSlug(slug string) error {
+	if slug == "" {
+		return errors.New("option slug is required")
+	}
+	if len(slug) > 50 {
+		return errors.New("option slug is too long (max 50 characters)")
+	}
+	if !slugRegex.MatchString(slug) {
+		return errors.New("option slug must contain only lowercase letters, numbers, and hyphens")
 	}
 	return nil
 }
@@ -0,0 +1,81 @@
+package attribute
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateTypeOptions(t *testing.T) {
+	enabled := []Option{{Name: "Red", Slug: "red", Enabled: true}}
+	disabled := []Option{{Name: "Red", Slug: "red"}}
+
+	tests := []struct {
+		attrType AttributeType
+		options  []Option
+		wantErr  bool
+	}{
+		{attrType: AttributeTypeSingle, options: enabled},
+		{attrType: AttributeTypeSingle, options: disabled, wantErr: true},
+		{attrType: AttributeTypeSingle, options: nil, wantErr: true},
+		{attrType: AttributeTypeMultiple, options: enabled},
+		{attrType: AttributeTypeMultiple, options: disabled, wantErr: true},
+		{attrType: AttributeTypeMultiple, options: nil, wantErr: true},
+		{attrType: AttributeTypeRange, options: nil},
+		{attrType: AttributeTypeRange, options: enabled, wantErr: true},
+		{attrType: AttributeTypeBoolean, options: nil},
+		{attrType: AttributeTypeBoolean, options: enabled, wantErr: true},
+		{attrType: AttributeTypeText, options: nil},
+		{attrType: AttributeTypeText, options: disabled, wantErr: true},
+		{attrType: AttributeTypeDate, options: nil},
+		{attrType: AttributeTypeDate, options: enabled, wantErr: true},
+		{attrType: AttributeTypeDatetime, options: nil},
+		{attrType: AttributeTypeDatetime, options: enabled, wantErr: true},
+		{attrType: AttributeTypeDimension, options: nil},
+		{attrType: AttributeTypeDimension, options: enabled, wantErr: true},
+		{attrType: AttributeTypeReference, options: nil},
+		{attrType: AttributeTypeReference, options: enabled, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.attrType), func(t *testing.T) {
+			err := validateTypeOptions(tt.attrType, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateTypeOptions(%s, %d options) error = %v, wantErr %v", tt.attrType, len(tt.options), err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsTypeChangeCompatible(t *testing.T) {
+	tests := []struct {
+		from AttributeType
+		to   AttributeType
+		want bool
+	}{
+		{from: AttributeTypeSingle, to: AttributeTypeSingle, want: true},
+		{from: AttributeTypeSingle, to: AttributeTypeMultiple, want: true},
+		{from: AttributeTypeMultiple, to: AttributeTypeSingle, want: false},
+		{from: AttributeTypeRange, to: AttributeTypeRange, want: true},
+		{from: AttributeTypeRange, to: AttributeTypeText, want: false},
+		{from: AttributeTypeText, to: AttributeTypeRange, want: false},
+		{from: AttributeTypeDate, to: AttributeTypeDatetime, want: false},
+		{from: AttributeTypeBoolean, to: AttributeTypeSingle, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			if got := isTypeChangeCompatible(tt.from, tt.to); got != tt.want {
+				t.Errorf("isTypeChangeCompatible(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+
+			a := &Attribute{Type: tt.from}
+			err := a.CheckAssignedTypeChange(tt.to)
+			if tt.want && err != nil {
+				t.Errorf("CheckAssignedTypeChange(%s) error = %v, want nil", tt.to, err)
+			}
+			if !tt.want && !errors.Is(err, ErrIncompatibleTypeChange) {
+				t.Errorf("CheckAssignedTypeChange(%s) error = %v, want %v", tt.to, err, ErrIncompatibleTypeChange)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package attribute
+
+import (
+	"fmt"
+	"time"
+)
+
+// dateLayout returns the value format of a date or datetime attribute
+func dateLayout(attrType AttributeType) string {
+	if attrType == AttributeTypeDate {
+		return time.DateOnly
+	}
+	return time.RFC3339
+}
+
+// contains reports whether t lies within the inclusive bounds
+func (cfg DateConfig) contains(attrType AttributeType, t time.Time) bool {
+	// bounds are validated when the attribute is saved
+	lower, _ := cfg.parseBound(attrType, cfg.Min)
+	upper, _ := cfg.parseBound(attrType, cfg.Max)
+	return (lower == nil || !t.Before(*lower)) && (upper == nil || !t.After(*upper))
+}
+
+func (cfg DateConfig) parseBound(attrType AttributeType, bound *string) (*time.Time, error) {
+	if bound == nil {
+		return nil, nil
+	}
+
+	t, err := time.Parse(dateLayout(attrType), *bound)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid %s", *bound, attrType)
+	}
+	return &t, nil
+}
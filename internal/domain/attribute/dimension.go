@@ -0,0 +1,70 @@
+package attribute
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+const maxDimensionComponents = 5
+
+func validateDimensionConfig(cfg DimensionConfig) error {
+	if len(cfg.Components) == 0 {
+		return errors.New("dimension requires at least one component")
+	}
+	if len(cfg.Components) > maxDimensionComponents {
+		return fmt.Errorf("dimension cannot have more than %d components", maxDimensionComponents)
+	}
+
+	keys := make(map[string]bool, len(cfg.Components))
+	for _, c := range cfg.Components {
+		if len(c.Key) > 50 || !slugRegex.MatchString(c.Key) {
+			return fmt.Errorf("dimension component key %q must be a slug of at most 50 characters", c.Key)
+		}
+		if keys[c.Key] {
+			return errors.New("duplicate dimension component key: " + c.Key)
+		}
+		keys[c.Key] = true
+
+		if c.Min >= c.Max {
+			return fmt.Errorf("dimension component %s: min must be less than max", c.Key)
+		}
+	}
+
+	return nil
+}
+
+// validateDimensionValue checks that value is a JSON object holding
+// an in-range number for every component and nothing else
+func (a *Attribute) validateDimensionValue(value *string) *ValueError {
+	var components map[string]float64
+	if err := json.Unmarshal([]byte(*value), &components); err != nil || components == nil {
+		return newValueError(ValueErrorInvalidDimension, value, "value %q is not an object of numeric components", *value)
+	}
+
+	cfg := a.TypeConfig.Dimension
+	if cfg == nil {
+		return nil
+	}
+
+	for _, c := range cfg.Components {
+		number, ok := components[c.Key]
+		if !ok {
+			return newValueError(ValueErrorInvalidDimension, value, "dimension component %s is missing", c.Key)
+		}
+		if number < c.Min || number > c.Max {
+			return newValueError(ValueErrorOutOfRange, value,
+				"dimension component %s value %v is outside of range [%v, %v]", c.Key, number, c.Min, c.Max)
+		}
+		delete(components, c.Key)
+	}
+
+	if len(components) > 0 {
+		unknown := slices.Sorted(maps.Keys(components))
+		return newValueError(ValueErrorInvalidDimension, value, "unknown dimension component %s", unknown[0])
+	}
+
+	return nil
+}
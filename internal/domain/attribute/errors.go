@@ -3,6 +3,12 @@ package attribute
 import "errors"
 
 var (
-	ErrSlugAlreadyExists    = errors.New("attribute with this slug already exists")
-	ErrInvalidAttributeData = errors.New("invalid attribute data")
+	ErrSlugAlreadyExists       = errors.New("attribute with this slug already exists")
+	ErrInvalidAttributeData    = errors.New("invalid attribute data")
+	ErrAttributeInUse          = errors.New("attribute is assigned to categories")
+	ErrIncompatibleTypeChange  = errors.New("attribute type change is incompatible with category assignments")
+	ErrUnknownOption           = errors.New("attribute option does not exist")
+	ErrOptionSlugTaken         = errors.New("option slug is already used by the attribute")
+	ErrInvalidStatusTransition = errors.New("attribute status transition is not allowed")
+	ErrAttributeNotAssignable  = errors.New("attribute cannot be assigned to categories in its current status")
 )
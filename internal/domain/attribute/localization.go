@@ -0,0 +1,65 @@
+package attribute
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+)
+
+// localeRegex accepts a language with an optional region, e.g. "uk" or "en-US"
+var localeRegex = regexp.MustCompile(`^[a-z]{2,3}(?:-[A-Z]{2})?$`)
+
+// Localized returns a copy of the attribute whose name, unit and option names
+// are taken from the first of locales that has a translation. Texts without a
+// translation keep their default locale values.
+func (a *Attribute) Localized(locales []string) *Attribute {
+	localized := *a
+
+	for _, locale := range locales {
+		if t, ok := a.Translations[locale]; ok {
+			localized.Name = t.Name
+			if t.Unit != nil {
+				localized.Unit = t.Unit
+			}
+			break
+		}
+	}
+
+	localized.Options = make([]Option, len(a.Options))
+	for i, opt := range a.Options {
+		localized.Options[i] = opt
+		for _, locale := range locales {
+			if name, ok := opt.Translations[locale]; ok {
+				localized.Options[i].Name = name
+				break
+			}
+		}
+	}
+
+	return &localized
+}
+
+func validateTranslations(translations map[string]Translation) error {
+	for locale, t := range translations {
+		if err := validateLocalizedName(locale, t.Name); err != nil {
+			return err
+		}
+		if t.Unit != nil && *t.Unit == "" {
+			return fmt.Errorf("translation %s: unit cannot be empty", locale)
+		}
+	}
+	return nil
+}
+
+func validateLocalizedName(locale string, name string) error {
+	if !localeRegex.MatchString(locale) {
+		return fmt.Errorf("invalid locale: %s", locale)
+	}
+	if name == "" {
+		return fmt.Errorf("translation %s: name is required", locale)
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return fmt.Errorf("translation %s: name is too long (max 100 characters)", locale)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package attribute
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// ResolveOption finds an option by its current slug or by a slug it had before
+// being renamed or absorbing another option
+func (a *Attribute) ResolveOption(slug string) (Option, bool) {
+	if opt, ok := a.findOption(slug); ok {
+		return opt, true
+	}
+	for _, opt := range a.Options {
+		if slices.Contains(opt.PreviousSlugs, slug) {
+			return opt, true
+		}
+	}
+	return Option{}, false
+}
+
+// CanonicalValues replaces retired option slugs among values with the current slugs of their options,
+// so that stored values never depend on the slug history. Other values are returned unchanged.
+func (a *Attribute) CanonicalValues(values []string) []string {
+	if values == nil || (a.Type != AttributeTypeSingle && a.Type != AttributeTypeMultiple) {
+		return values
+	}
+
+	return lo.Map(values, func(value string, _ int) string {
+		if opt, ok := a.ResolveOption(value); ok {
+			return opt.Slug
+		}
+		return value
+	})
+}
+
+// RenameOption changes the slug of an option; the old slug keeps resolving to the option
+func (a *Attribute) RenameOption(slug string, newSlug string) error {
+	i := a.optionIndex(slug)
+	if i < 0 {
+		return fmt.Errorf("option %q: %w", slug, ErrUnknownOption)
+	}
+
+	if err := validateOptionSlug(newSlug); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAttributeData, err)
+	}
+	if newSlug == slug {
+		return fmt.Errorf("%w: new option slug must differ from the current one", ErrInvalidAttributeData)
+	}
+	if owner, ok := a.ResolveOption(newSlug); ok && owner.Slug != slug {
+		return fmt.Errorf("option slug %s: %w", newSlug, ErrOptionSlugTaken)
+	}
+
+	opt := &a.Options[i]
+	// renaming back to a retired slug takes it out of the history
+	opt.PreviousSlugs = append(lo.Without(opt.PreviousSlugs, newSlug), slug)
+	opt.Slug = newSlug
+	a.ModifiedAt = time.Now().UTC()
+
+	return nil
+}
+
+// MergeOptions folds the source option into the target one: the source option is
+// removed and its slugs resolve to the target option from now on. The target must be
+// enabled, as the values and category restrictions moved onto it must stay usable.
+func (a *Attribute) MergeOptions(sourceSlug string, targetSlug string) error {
+	if sourceSlug == targetSlug {
+		return fmt.Errorf("%w: an option cannot be merged into itself", ErrInvalidAttributeData)
+	}
+
+	src := a.optionIndex(sourceSlug)
+	if src < 0 {
+		return fmt.Errorf("option %q: %w", sourceSlug, ErrUnknownOption)
+	}
+	tgt := a.optionIndex(targetSlug)
+	if tgt < 0 {
+		return fmt.Errorf("option %q: %w", targetSlug, ErrUnknownOption)
+	}
+	if !a.Options[tgt].Enabled {
+		return fmt.Errorf("%w: target option %q is disabled", ErrInvalidAttributeData, targetSlug)
+	}
+
+	options := slices.Clone(a.Options)
+	options[tgt].PreviousSlugs = slices.Concat(options[tgt].PreviousSlugs, options[src].PreviousSlugs, []string{sourceSlug})
+	options = slices.Delete(options, src, src+1)
+
+	if err := validateTypeOptions(a.Type, options); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAttributeData, err)
+	}
+
+	a.Options = options
+	a.ModifiedAt = time.Now().UTC()
+
+	return nil
+}
+
+// withSlugHistory keeps the previous slugs of options that survive a full options replace
+func (a *Attribute) withSlugHistory(options []Option) []Option {
+	for i := range options {
+		if existing, ok := a.findOption(options[i].Slug); ok && options[i].PreviousSlugs == nil {
+			options[i].PreviousSlugs = existing.PreviousSlugs
+		}
+	}
+	return options
+}
+
+func (a *Attribute) optionIndex(slug string) int {
+	return slices.IndexFunc(a.Options, func(opt Option) bool {
+		return opt.Slug == slug
+	})
+}
@@ -0,0 +1,109 @@
+package attribute
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func newColorAttribute() *Attribute {
+	return &Attribute{
+		Type: AttributeTypeSingle,
+		Options: []Option{
+			{Name: "Red", Slug: "red", Enabled: true, PreviousSlugs: []string{"crimson"}},
+			{Name: "Blue", Slug: "blue", Enabled: true, PreviousSlugs: []string{"navy"}},
+			{Name: "Green", Slug: "green"},
+		},
+	}
+}
+
+func TestRenameOption(t *testing.T) {
+	tests := []struct {
+		name              string
+		slug              string
+		newSlug           string
+		wantErr           error
+		wantPreviousSlugs []string
+	}{
+		{name: "rename", slug: "red", newSlug: "scarlet", wantPreviousSlugs: []string{"crimson", "red"}},
+		{name: "back to a retired slug", slug: "red", newSlug: "crimson", wantPreviousSlugs: []string{"red"}},
+		{name: "current slug of another option", slug: "red", newSlug: "blue", wantErr: ErrOptionSlugTaken},
+		{name: "retired slug of another option", slug: "red", newSlug: "navy", wantErr: ErrOptionSlugTaken},
+		{name: "same slug", slug: "red", newSlug: "red", wantErr: ErrInvalidAttributeData},
+		{name: "invalid slug", slug: "red", newSlug: "Scarlet Red", wantErr: ErrInvalidAttributeData},
+		{name: "unknown option", slug: "pink", newSlug: "rose", wantErr: ErrUnknownOption},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newColorAttribute()
+
+			err := a.RenameOption(tt.slug, tt.newSlug)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("RenameOption(%s, %s) error = %v, want %v", tt.slug, tt.newSlug, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RenameOption(%s, %s) error = %v", tt.slug, tt.newSlug, err)
+			}
+
+			opt, ok := a.ResolveOption(tt.slug)
+			if !ok || opt.Slug != tt.newSlug {
+				t.Errorf("ResolveOption(%s) = %v, %v, want the option renamed to %s", tt.slug, opt.Slug, ok, tt.newSlug)
+			}
+			if !slices.Equal(opt.PreviousSlugs, tt.wantPreviousSlugs) {
+				t.Errorf("PreviousSlugs = %q, want %q", opt.PreviousSlugs, tt.wantPreviousSlugs)
+			}
+		})
+	}
+}
+
+func TestMergeOptions(t *testing.T) {
+	tests := []struct {
+		name              string
+		source            string
+		target            string
+		wantErr           error
+		wantPreviousSlugs []string
+	}{
+		{name: "merge", source: "blue", target: "red", wantPreviousSlugs: []string{"crimson", "navy", "blue"}},
+		{name: "disabled source", source: "green", target: "red", wantPreviousSlugs: []string{"crimson", "green"}},
+		{name: "disabled target", source: "red", target: "green", wantErr: ErrInvalidAttributeData},
+		{name: "into itself", source: "red", target: "red", wantErr: ErrInvalidAttributeData},
+		{name: "unknown source", source: "pink", target: "red", wantErr: ErrUnknownOption},
+		{name: "unknown target", source: "red", target: "pink", wantErr: ErrUnknownOption},
+		{name: "retired source slug", source: "crimson", target: "blue", wantErr: ErrUnknownOption},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newColorAttribute()
+
+			err := a.MergeOptions(tt.source, tt.target)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("MergeOptions(%s, %s) error = %v, want %v", tt.source, tt.target, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MergeOptions(%s, %s) error = %v", tt.source, tt.target, err)
+			}
+
+			if a.HasOption(tt.source) {
+				t.Errorf("HasOption(%s) = true after the merge, want false", tt.source)
+			}
+			for _, slug := range tt.wantPreviousSlugs {
+				if opt, ok := a.ResolveOption(slug); !ok || opt.Slug != tt.target {
+					t.Errorf("ResolveOption(%s) = %v, %v, want %s", slug, opt.Slug, ok, tt.target)
+				}
+			}
+			target, _ := a.ResolveOption(tt.target)
+			if !slices.Equal(target.PreviousSlugs, tt.wantPreviousSlugs) {
+				t.Errorf("PreviousSlugs = %q, want %q", target.PreviousSlugs, tt.wantPreviousSlugs)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package attribute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+// ReferenceResolver looks up entities owned by other services, e.g. brands or countries
+type ReferenceResolver interface {
+	// Resolve returns the IDs among ids that name existing entities of the kind, in no particular order
+	Resolve(ctx context.Context, kind string, ids []string) ([]string, error)
+}
+
+const maxReferenceIDLength = 100
+
+func validateReferenceConfig(cfg ReferenceConfig) error {
+	if len(cfg.TargetKind) > 50 || !slugRegex.MatchString(cfg.TargetKind) {
+		return fmt.Errorf("reference target kind %q must be a slug of at most 50 characters", cfg.TargetKind)
+	}
+	return nil
+}
+
+// ResolveReferences checks that values of a reference attribute name existing entities of its target kind.
+// values are expected to have passed ValidateValues; other attribute types are not checked.
+func (a *Attribute) ResolveReferences(ctx context.Context, resolver ReferenceResolver, values []string) ([]ValueError, error) {
+	if a.Type != AttributeTypeReference || a.TypeConfig.Reference == nil || len(values) == 0 {
+		return nil, nil
+	}
+
+	kind := a.TypeConfig.Reference.TargetKind
+	resolved, err := resolver.Resolve(ctx, kind, lo.Uniq(values))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s references: %w", kind, err)
+	}
+	found := lo.SliceToMap(resolved, func(id string) (string, bool) {
+		return id, true
+	})
+
+	var errs []ValueError
+	for i := range values {
+		if value := &values[i]; !found[*value] {
+			errs = append(errs, *newValueError(ValueErrorUnknownReference, value, "%s %q does not exist", kind, *value))
+		}
+	}
+	return errs, nil
+}
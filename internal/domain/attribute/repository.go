@@ -11,6 +11,7 @@ type ListQuery struct {
 	Size    int
 	Enabled *bool
 	Type    *string
+	Status  *string // nil lists all but archived attributes
 	Sort    string
 	Order   string
 }
@@ -20,9 +21,28 @@ type Repository interface {
 
 	FindByID(ctx context.Context, id string) (*Attribute, error)
 
+	// FindByIDs returns the attributes that exist among the given IDs, in no particular order
+	FindByIDs(ctx context.Context, ids []string) ([]*Attribute, error)
+
+	// FindBySlug returns the attribute currently using the slug or persistence.ErrEntityNotFound
+	FindBySlug(ctx context.Context, slug string) (*Attribute, error)
+
+	// FindByPreviousSlug returns the attribute that used the slug before, the most recently
+	// modified one if several did, or persistence.ErrEntityNotFound
+	FindByPreviousSlug(ctx context.Context, slug string) (*Attribute, error)
+
 	FindList(ctx context.Context, query ListQuery) (*commonsmongo.PageResult[Attribute], error)
 
 	Update(ctx context.Context, attribute *Attribute) (*Attribute, error)
 
 	Exists(ctx context.Context, id string) (bool, error)
+
+	// LockForAssignment returns the attribute after writing to it, so that a transaction assigning
+	// the attribute to categories conflicts with concurrent transactions updating or deleting it.
+	// Returns persistence.ErrEntityNotFound if the attribute does not exist.
+	LockForAssignment(ctx context.Context, id string) (*Attribute, error)
+
+	// Delete removes the attribute if its version matches.
+	// Returns persistence.ErrEntityNotFound or persistence.ErrOptimisticLocking otherwise.
+	Delete(ctx context.Context, id string, version int) error
 }
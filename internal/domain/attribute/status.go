@@ -0,0 +1,46 @@
+package attribute
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// AttributeStatus represents the lifecycle state of an attribute
+type AttributeStatus string
+
+const (
+	AttributeStatusDraft      AttributeStatus = "draft"      // being prepared
+	AttributeStatusActive     AttributeStatus = "active"     // in regular use
+	AttributeStatusDeprecated AttributeStatus = "deprecated" // readable, but no longer assignable to categories
+	AttributeStatusArchived   AttributeStatus = "archived"   // hidden from default lists
+)
+
+// statusTransitions lists the states each state may move to
+var statusTransitions = map[AttributeStatus][]AttributeStatus{
+	AttributeStatusDraft:      {AttributeStatusActive, AttributeStatusArchived},
+	AttributeStatusActive:     {AttributeStatusDeprecated},
+	AttributeStatusDeprecated: {AttributeStatusActive, AttributeStatusArchived},
+	AttributeStatusArchived:   {AttributeStatusDeprecated},
+}
+
+// ChangeStatus moves the attribute to another lifecycle state
+func (a *Attribute) ChangeStatus(status AttributeStatus) error {
+	if !slices.Contains(statusTransitions[a.Status], status) {
+		return fmt.Errorf("%s -> %s: %w", a.Status, status, ErrInvalidStatusTransition)
+	}
+
+	a.Status = status
+	a.ModifiedAt = time.Now().UTC()
+
+	return nil
+}
+
+// IsAssignable reports whether the attribute may be newly assigned to categories
+func (a *Attribute) IsAssignable() bool {
+	return a.Status == AttributeStatusDraft || a.Status == AttributeStatusActive
+}
+
+func isValidInitialStatus(status AttributeStatus) bool {
+	return status == AttributeStatusDraft || status == AttributeStatusActive
+}
@@ -0,0 +1,59 @@
+package attribute
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestChangeStatus(t *testing.T) {
+	statuses := []AttributeStatus{AttributeStatusDraft, AttributeStatusActive, AttributeStatusDeprecated, AttributeStatusArchived}
+	allowed := map[AttributeStatus][]AttributeStatus{
+		AttributeStatusDraft:      {AttributeStatusActive, AttributeStatusArchived},
+		AttributeStatusActive:     {AttributeStatusDeprecated},
+		AttributeStatusDeprecated: {AttributeStatusActive, AttributeStatusArchived},
+		AttributeStatusArchived:   {AttributeStatusDeprecated},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			t.Run(string(from)+"->"+string(to), func(t *testing.T) {
+				a := &Attribute{Status: from}
+				wantAllowed := slices.Contains(allowed[from], to)
+
+				err := a.ChangeStatus(to)
+				switch {
+				case wantAllowed && err != nil:
+					t.Errorf("ChangeStatus() error = %v, want nil", err)
+				case wantAllowed && a.Status != to:
+					t.Errorf("Status = %s, want %s", a.Status, to)
+				case !wantAllowed && !errors.Is(err, ErrInvalidStatusTransition):
+					t.Errorf("ChangeStatus() error = %v, want %v", err, ErrInvalidStatusTransition)
+				case !wantAllowed && a.Status != from:
+					t.Errorf("Status = %s after a rejected transition, want %s", a.Status, from)
+				}
+			})
+		}
+	}
+}
+
+func TestIsAssignable(t *testing.T) {
+	tests := []struct {
+		status AttributeStatus
+		want   bool
+	}{
+		{status: AttributeStatusDraft, want: true},
+		{status: AttributeStatusActive, want: true},
+		{status: AttributeStatusDeprecated, want: false},
+		{status: AttributeStatusArchived, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			a := &Attribute{Status: tt.status}
+			if got := a.IsAssignable(); got != tt.want {
+				t.Errorf("IsAssignable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
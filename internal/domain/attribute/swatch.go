@@ -0,0 +1,98 @@
+package attribute
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SwatchMode tells the storefront how to render the options of an attribute
+type SwatchMode string
+
+const (
+	SwatchModeText  SwatchMode = "text"  // plain text chips
+	SwatchModeColor SwatchMode = "color" // every option carries a color code
+	SwatchModeImage SwatchMode = "image" // every option carries an image
+)
+
+// OptionImage is an image swatch of an option
+type OptionImage struct {
+	URL string
+	Alt string
+}
+
+const maxImageURLLength = 2048
+
+var (
+	hexColorRegex  = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	rgbaColorRegex = regexp.MustCompile(`^rgba\(\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(0|1|0?\.\d+|1\.0+)\s*\)$`)
+)
+
+// validateColorCode accepts #RGB, #RRGGBB, #RRGGBBAA and rgba(r, g, b, a)
+// with channels in 0-255 and alpha in 0-1
+func validateColorCode(code string) error {
+	if hexColorRegex.MatchString(code) {
+		return nil
+	}
+
+	match := rgbaColorRegex.FindStringSubmatch(strings.ToLower(code))
+	if match == nil {
+		return fmt.Errorf("color code %q must be #RGB, #RRGGBB, #RRGGBBAA or rgba(r, g, b, a)", code)
+	}
+	for _, channel := range match[1:4] {
+		if n, _ := strconv.Atoi(channel); n > 255 {
+			return fmt.Errorf("color code %q: channels must be between 0 and 255", code)
+		}
+	}
+	return nil
+}
+
+func validateOptionImage(image OptionImage) error {
+	if len(image.URL) > maxImageURLLength {
+		return errors.New("option image URL is too long (max 2048 characters)")
+	}
+
+	u, err := url.Parse(image.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("option image URL %q must be an absolute http(s) URL", image.URL)
+	}
+
+	if strings.TrimSpace(image.Alt) == "" {
+		return errors.New("option image alt text is required")
+	}
+	if utf8.RuneCountInString(image.Alt) > maxNameLength {
+		return errors.New("option image alt text is too long (max 100 characters)")
+	}
+
+	return nil
+}
+
+// validateSwatchMode checks the mode against the attribute type and requires
+// every option to carry the swatch the mode renders
+func validateSwatchMode(mode SwatchMode, attrType AttributeType, options []Option) error {
+	switch mode {
+	case SwatchModeText:
+		return nil
+	case SwatchModeColor, SwatchModeImage:
+	default:
+		return fmt.Errorf("invalid swatch mode: %s", mode)
+	}
+
+	if attrType != AttributeTypeSingle && attrType != AttributeTypeMultiple {
+		return fmt.Errorf("%s swatch mode is allowed only for attributes with options", mode)
+	}
+
+	for _, opt := range options {
+		if mode == SwatchModeColor && opt.ColorCode == nil {
+			return fmt.Errorf("option %s: color code is required by the color swatch mode", opt.Slug)
+		}
+		if mode == SwatchModeImage && opt.Image == nil {
+			return fmt.Errorf("option %s: image is required by the image swatch mode", opt.Slug)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package attribute
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxTextLength        = 10000
+	maxTextPatternLength = 500
+)
+
+func validateTextConfig(cfg TextConfig) error {
+	if cfg.MinLength != nil && (*cfg.MinLength < 0 || *cfg.MinLength > maxTextLength) {
+		return fmt.Errorf("text min length must be between 0 and %d", maxTextLength)
+	}
+	if cfg.MaxLength != nil && (*cfg.MaxLength < 1 || *cfg.MaxLength > maxTextLength) {
+		return fmt.Errorf("text max length must be between 1 and %d", maxTextLength)
+	}
+	if cfg.MinLength != nil && cfg.MaxLength != nil && *cfg.MinLength > *cfg.MaxLength {
+		return errors.New("text min length cannot exceed max length")
+	}
+
+	if cfg.Pattern != nil {
+		if len(*cfg.Pattern) > maxTextPatternLength {
+			return fmt.Errorf("text pattern cannot be longer than %d characters", maxTextPatternLength)
+		}
+		if _, err := regexp.Compile(*cfg.Pattern); err != nil {
+			return fmt.Errorf("text pattern is not a valid regular expression: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// textPattern compiles the pattern of a text attribute once per validation run, anchored
+// so that it has to match the whole value. It is nil when values are not matched against a pattern.
+func (a *Attribute) textPattern() *regexp.Regexp {
+	if a.Type != AttributeTypeText || a.TypeConfig.Text == nil || a.TypeConfig.Text.Pattern == nil {
+		return nil
+	}
+
+	// the pattern is validated when the attribute is saved
+	pattern, err := regexp.Compile(`^(?:` + *a.TypeConfig.Text.Pattern + `)$`)
+	if err != nil {
+		return nil
+	}
+	return pattern
+}
+
+func (a *Attribute) validateTextValue(value *string, pattern *regexp.Regexp) *ValueError {
+	if strings.TrimSpace(*value) == "" {
+		return newValueError(ValueErrorInvalidText, value, "text value cannot be blank")
+	}
+
+	cfg := a.TypeConfig.Text
+	if cfg == nil {
+		return nil
+	}
+
+	if !cfg.Multiline && strings.ContainsAny(*value, "\r\n") {
+		return newValueError(ValueErrorInvalidText, value, "text value cannot span multiple lines")
+	}
+
+	length := utf8.RuneCountInString(*value)
+	if cfg.MinLength != nil && length < *cfg.MinLength {
+		return newValueError(ValueErrorTooShort, value, "text value must be at least %d characters long", *cfg.MinLength)
+	}
+	if cfg.MaxLength != nil && length > *cfg.MaxLength {
+		return newValueError(ValueErrorTooLong, value, "text value cannot be longer than %d characters", *cfg.MaxLength)
+	}
+
+	if pattern != nil && !pattern.MatchString(*value) {
+		return newValueError(ValueErrorPatternMismatch, value, "text value %q does not match pattern %s", *value, *cfg.Pattern)
+	}
+
+	return nil
+}
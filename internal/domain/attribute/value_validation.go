@@ -0,0 +1,219 @@
+package attribute
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// ValueErrorCode identifies why a product attribute value was rejected
+type ValueErrorCode string
+
+const (
+	ValueErrorRequired          ValueErrorCode = "required"
+	ValueErrorUnknownAttribute  ValueErrorCode = "unknown_attribute"
+	ValueErrorDisabledAttribute ValueErrorCode = "disabled_attribute"
+	ValueErrorTooManyValues     ValueErrorCode = "too_many_values"
+	ValueErrorDuplicateValue    ValueErrorCode = "duplicate_value"
+	ValueErrorUnknownOption     ValueErrorCode = "unknown_option"
+	ValueErrorDisabledOption    ValueErrorCode = "disabled_option"
+	ValueErrorOptionNotAllowed  ValueErrorCode = "option_not_allowed"
+	ValueErrorInvalidNumber     ValueErrorCode = "invalid_number"
+	ValueErrorOutOfRange        ValueErrorCode = "out_of_range"
+	ValueErrorStepMismatch      ValueErrorCode = "step_mismatch"
+	ValueErrorTooPrecise        ValueErrorCode = "too_precise"
+	ValueErrorInvalidBoolean    ValueErrorCode = "invalid_boolean"
+	ValueErrorInvalidText       ValueErrorCode = "invalid_text"
+	ValueErrorTooShort          ValueErrorCode = "too_short"
+	ValueErrorTooLong           ValueErrorCode = "too_long"
+	ValueErrorPatternMismatch   ValueErrorCode = "pattern_mismatch"
+	ValueErrorInvalidDate       ValueErrorCode = "invalid_date"
+	ValueErrorInvalidDimension  ValueErrorCode = "invalid_dimension"
+	ValueErrorInvalidReference  ValueErrorCode = "invalid_reference"
+	ValueErrorUnknownReference  ValueErrorCode = "unknown_reference"
+)
+
+// ValueError describes a single rejected product attribute value
+type ValueError struct {
+	Code    ValueErrorCode
+	Value   *string // offending value, nil when the error concerns the whole field
+	Message string
+}
+
+func (e ValueError) Error() string {
+	return e.Message
+}
+
+func newValueError(code ValueErrorCode, value *string, format string, args ...any) *ValueError {
+	return &ValueError{
+		Code:    code,
+		Value:   value,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// ValidateValues checks product values against the attribute definition.
+// values must be non-empty; whether a value is required is decided by the category assignment.
+// allowedOptions restricts the option slugs a category accepts, nil allows all options.
+// Whether referenced external entities exist is checked by ResolveReferences.
+func (a *Attribute) ValidateValues(values []string, allowedOptions []string) []ValueError {
+	if a.Type != AttributeTypeMultiple && len(values) > 1 {
+		return []ValueError{*newValueError(ValueErrorTooManyValues, nil,
+			"%s attribute accepts a single value, got %d", a.Type, len(values))}
+	}
+
+	var errs []ValueError
+	pattern := a.textPattern()
+	seen := make(map[string]bool, len(values))
+	for i := range values {
+		value := &values[i]
+		if seen[*value] {
+			errs = append(errs, *newValueError(ValueErrorDuplicateValue, value, "value %q is repeated", *value))
+			continue
+		}
+		seen[*value] = true
+
+		if err := a.validateValue(value, allowedOptions, pattern); err != nil {
+			errs = append(errs, *err)
+		}
+	}
+	return errs
+}
+
+// RetainValidValues keeps the values that still pass ValidateValues, moved to the current
+// slugs of their options. Attributes that accept a single value keep only the first of them.
+func (a *Attribute) RetainValidValues(values []string, allowedOptions []string) []string {
+	var retained []string
+	pattern := a.textPattern()
+	for _, value := range a.CanonicalValues(values) {
+		if a.Type != AttributeTypeMultiple && len(retained) == 1 {
+			break
+		}
+		if slices.Contains(retained, value) {
+			continue
+		}
+		if a.validateValue(&value, allowedOptions, pattern) == nil {
+			retained = append(retained, value)
+		}
+	}
+	return retained
+}
+
+// validateValue checks a single value; pattern is the compiled textPattern of the attribute
+func (a *Attribute) validateValue(value *string, allowedOptions []string, pattern *regexp.Regexp) *ValueError {
+	switch a.Type {
+	case AttributeTypeSingle, AttributeTypeMultiple:
+		opt, ok := a.ResolveOption(*value)
+		if !ok {
+			return newValueError(ValueErrorUnknownOption, value, "option %q does not exist", *value)
+		}
+		if !opt.Enabled {
+			return newValueError(ValueErrorDisabledOption, value, "option %q is disabled", *value)
+		}
+		if allowedOptions != nil && !slices.Contains(allowedOptions, opt.Slug) {
+			return newValueError(ValueErrorOptionNotAllowed, value, "option %q is not allowed in the category", *value)
+		}
+	case AttributeTypeRange:
+		return a.validateRangeValue(value)
+	case AttributeTypeBoolean:
+		if *value != "true" && *value != "false" {
+			return newValueError(ValueErrorInvalidBoolean, value, "value %q is not a boolean", *value)
+		}
+	case AttributeTypeText:
+		return a.validateTextValue(value, pattern)
+	case AttributeTypeDate, AttributeTypeDatetime:
+		t, err := time.Parse(dateLayout(a.Type), *value)
+		if err != nil {
+			return newValueError(ValueErrorInvalidDate, value, "value %q is not a valid %s", *value, a.Type)
+		}
+		if cfg := a.TypeConfig.Date; cfg != nil && !cfg.contains(a.Type, t) {
+			return newValueError(ValueErrorOutOfRange, value, "value %q is outside of range [%s, %s]",
+				*value, lo.FromPtrOr(cfg.Min, "-"), lo.FromPtrOr(cfg.Max, "-"))
+		}
+	case AttributeTypeDimension:
+		return a.validateDimensionValue(value)
+	case AttributeTypeReference:
+		if strings.TrimSpace(*value) == "" || len(*value) > maxReferenceIDLength {
+			return newValueError(ValueErrorInvalidReference, value,
+				"reference must be a non-blank ID of at most %d characters", maxReferenceIDLength)
+		}
+	}
+	return nil
+}
+
+// CheckOptionSlugs verifies that every slug names an option of the attribute
+func (a *Attribute) CheckOptionSlugs(slugs []string) error {
+	if len(slugs) > 0 && a.Type != AttributeTypeSingle && a.Type != AttributeTypeMultiple {
+		return fmt.Errorf("%s attribute has no options: %w", a.Type, ErrUnknownOption)
+	}
+
+	for _, slug := range slugs {
+		if _, ok := a.findOption(slug); !ok {
+			return fmt.Errorf("option %q: %w", slug, ErrUnknownOption)
+		}
+	}
+	return nil
+}
+
+// stepTolerance absorbs float rounding when checking that a value lies on a step
+const stepTolerance = 1e-9
+
+func (a *Attribute) validateRangeValue(value *string) *ValueError {
+	number, err := strconv.ParseFloat(*value, 64)
+	// ParseFloat accepts NaN and infinities, which no range comparison can reject
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return newValueError(ValueErrorInvalidNumber, value, "value %q is not a number", *value)
+	}
+
+	cfg := a.TypeConfig.Range
+	if cfg == nil {
+		return nil
+	}
+
+	if number < cfg.Min || number > cfg.Max {
+		return newValueError(ValueErrorOutOfRange, value, "value %v is outside of range [%v, %v]", number, cfg.Min, cfg.Max)
+	}
+
+	if cfg.Precision != nil && decimalPlaces(number) > *cfg.Precision {
+		return newValueError(ValueErrorTooPrecise, value, "value %v has more than %d decimal places", number, *cfg.Precision)
+	}
+
+	if cfg.Step != nil {
+		steps := (number - cfg.Min) / *cfg.Step
+		if math.Abs(steps-math.Round(steps)) > stepTolerance*math.Max(1, math.Abs(steps)) {
+			return newValueError(ValueErrorStepMismatch, value, "value %v is not %v plus a multiple of step %v", number, cfg.Min, *cfg.Step)
+		}
+	}
+
+	return nil
+}
+
+// decimalPlaces counts the fractional digits of the shortest representation of number
+func decimalPlaces(number float64) int {
+	formatted := strconv.FormatFloat(number, 'f', -1, 64)
+	if i := strings.IndexByte(formatted, '.'); i >= 0 {
+		return len(formatted) - i - 1
+	}
+	return 0
+}
+
+// HasOption reports whether slug is the current slug of an option of the attribute
+func (a *Attribute) HasOption(slug string) bool {
+	_, ok := a.findOption(slug)
+	return ok
+}
+
+func (a *Attribute) findOption(slug string) (Option, bool) {
+	for _, opt := range a.Options {
+		if opt.Slug == slug {
+			return opt, true
+		}
+	}
+	return Option{}, false
+}
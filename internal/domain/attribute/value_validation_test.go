@@ -0,0 +1,120 @@
+package attribute
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/samber/lo"
+)
+
+func TestValidateValues(t *testing.T) {
+	color := &Attribute{
+		Type: AttributeTypeSingle,
+		Options: []Option{
+			{Slug: "red", Enabled: true, PreviousSlugs: []string{"crimson"}},
+			{Slug: "blue", Enabled: true},
+			{Slug: "green"},
+		},
+	}
+	sizes := &Attribute{
+		Type:    AttributeTypeMultiple,
+		Options: []Option{{Slug: "s", Enabled: true}, {Slug: "m", Enabled: true}},
+	}
+	weight := &Attribute{
+		Type:       AttributeTypeRange,
+		TypeConfig: TypeConfig{Range: &RangeConfig{Min: 0, Max: 10, Step: lo.ToPtr(0.5), Precision: lo.ToPtr(1)}},
+	}
+	code := &Attribute{
+		Type:       AttributeTypeText,
+		TypeConfig: TypeConfig{Text: &TextConfig{Pattern: lo.ToPtr(`[A-Z]{3}`)}},
+	}
+
+	tests := []struct {
+		name           string
+		attribute      *Attribute
+		values         []string
+		allowedOptions []string
+		want           []ValueErrorCode
+	}{
+		{name: "option", attribute: color, values: []string{"red"}},
+		{name: "retired option slug", attribute: color, values: []string{"crimson"}},
+		{name: "unknown option", attribute: color, values: []string{"pink"}, want: []ValueErrorCode{ValueErrorUnknownOption}},
+		{name: "disabled option", attribute: color, values: []string{"green"}, want: []ValueErrorCode{ValueErrorDisabledOption}},
+		{name: "option not allowed", attribute: color, values: []string{"blue"}, allowedOptions: []string{"red"},
+			want: []ValueErrorCode{ValueErrorOptionNotAllowed}},
+		{name: "retired slug of an allowed option", attribute: color, values: []string{"crimson"}, allowedOptions: []string{"red"}},
+		{name: "several values of a single attribute", attribute: color, values: []string{"red", "blue"},
+			want: []ValueErrorCode{ValueErrorTooManyValues}},
+		{name: "several values of a multiple attribute", attribute: sizes, values: []string{"s", "m"}},
+		{name: "repeated value", attribute: sizes, values: []string{"s", "s"}, want: []ValueErrorCode{ValueErrorDuplicateValue}},
+		{name: "number", attribute: weight, values: []string{"2.5"}},
+		{name: "range bound", attribute: weight, values: []string{"10"}},
+		{name: "not a number", attribute: weight, values: []string{"heavy"}, want: []ValueErrorCode{ValueErrorInvalidNumber}},
+		{name: "NaN", attribute: weight, values: []string{"NaN"}, want: []ValueErrorCode{ValueErrorInvalidNumber}},
+		{name: "infinity", attribute: weight, values: []string{"Inf"}, want: []ValueErrorCode{ValueErrorInvalidNumber}},
+		{name: "negative infinity", attribute: weight, values: []string{"-Inf"}, want: []ValueErrorCode{ValueErrorInvalidNumber}},
+		{name: "out of range", attribute: weight, values: []string{"10.5"}, want: []ValueErrorCode{ValueErrorOutOfRange}},
+		{name: "off step", attribute: weight, values: []string{"0.7"}, want: []ValueErrorCode{ValueErrorStepMismatch}},
+		{name: "too precise", attribute: weight, values: []string{"2.25"}, want: []ValueErrorCode{ValueErrorTooPrecise}},
+		{name: "boolean", attribute: &Attribute{Type: AttributeTypeBoolean}, values: []string{"true"}},
+		{name: "not a boolean", attribute: &Attribute{Type: AttributeTypeBoolean}, values: []string{"yes"},
+			want: []ValueErrorCode{ValueErrorInvalidBoolean}},
+		{name: "date", attribute: &Attribute{Type: AttributeTypeDate}, values: []string{"2024-02-29"}},
+		{name: "invalid date", attribute: &Attribute{Type: AttributeTypeDate}, values: []string{"2023-02-29"},
+			want: []ValueErrorCode{ValueErrorInvalidDate}},
+		{name: "text matching the pattern", attribute: code, values: []string{"ABC"}},
+		{name: "pattern must match the whole text", attribute: code, values: []string{"ABCD"},
+			want: []ValueErrorCode{ValueErrorPatternMismatch}},
+		{name: "blank text", attribute: code, values: []string{" "}, want: []ValueErrorCode{ValueErrorInvalidText}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.attribute.ValidateValues(tt.values, tt.allowedOptions)
+			got := lo.Map(errs, func(e ValueError, _ int) ValueErrorCode {
+				return e.Code
+			})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ValidateValues(%q) = %v, want codes %v", tt.values, errs, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetainValidValues(t *testing.T) {
+	options := []Option{
+		{Slug: "red", Enabled: true, PreviousSlugs: []string{"crimson"}},
+		{Slug: "blue", Enabled: true},
+		{Slug: "green"},
+	}
+
+	tests := []struct {
+		name           string
+		attribute      *Attribute
+		values         []string
+		allowedOptions []string
+		want           []string
+	}{
+		{name: "no values", attribute: &Attribute{Type: AttributeTypeMultiple, Options: options}, values: nil, want: nil},
+		{name: "invalid values are dropped", attribute: &Attribute{Type: AttributeTypeMultiple, Options: options},
+			values: []string{"red", "green", "pink", "blue"}, want: []string{"red", "blue"}},
+		{name: "retired slugs move to current ones", attribute: &Attribute{Type: AttributeTypeMultiple, Options: options},
+			values: []string{"crimson", "red"}, want: []string{"red"}},
+		{name: "options not allowed are dropped", attribute: &Attribute{Type: AttributeTypeMultiple, Options: options},
+			values: []string{"red", "blue"}, allowedOptions: []string{"blue"}, want: []string{"blue"}},
+		{name: "single attribute keeps the first valid value", attribute: &Attribute{Type: AttributeTypeSingle, Options: options},
+			values: []string{"pink", "blue", "red"}, want: []string{"blue"}},
+		{name: "nothing valid remains", attribute: &Attribute{Type: AttributeTypeSingle, Options: options},
+			values: []string{"green"}, want: nil},
+		{name: "tightened range", attribute: &Attribute{Type: AttributeTypeRange, TypeConfig: TypeConfig{Range: &RangeConfig{Min: 0, Max: 5}}},
+			values: []string{"7"}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.attribute.RetainValidValues(tt.values, tt.allowedOptions); !slices.Equal(got, tt.want) {
+				t.Errorf("RetainValidValues(%q) = %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package attributegroup
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttributeGroup organizes category attributes into sections of a product spec sheet
+type AttributeGroup struct {
+	ID         string
+	Version    int
+	Name       string
+	Slug       string
+	SortOrder  int
+	CreatedAt  time.Time
+	ModifiedAt time.Time
+}
+
+var slugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// NewAttributeGroup creates a new attribute group with validation.
+// If id is empty, a new UUID will be generated.
+func NewAttributeGroup(
+	id string,
+	name string,
+	slug string,
+	sortOrder int,
+) (*AttributeGroup, error) {
+	if err := validateAttributeGroupData(name, slug, sortOrder); err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	now := time.Now().UTC()
+	return &AttributeGroup{
+		ID:         id,
+		Version:    1,
+		Name:       name,
+		Slug:       slug,
+		SortOrder:  sortOrder,
+		CreatedAt:  now,
+		ModifiedAt: now,
+	}, nil
+}
+
+// Reconstruct rebuilds an attribute group from persistence (no validation)
+func Reconstruct(
+	id string,
+	version int,
+	name string,
+	slug string,
+	sortOrder int,
+	createdAt time.Time,
+	modifiedAt time.Time,
+) *AttributeGroup {
+	return &AttributeGroup{
+		ID:         id,
+		Version:    version,
+		Name:       name,
+		Slug:       slug,
+		SortOrder:  sortOrder,
+		CreatedAt:  createdAt,
+		ModifiedAt: modifiedAt,
+	}
+}
+
+// Update modifies attribute group data with validation
+func (g *AttributeGroup) Update(
+	name string,
+	slug string,
+	sortOrder int,
+) error {
+	if err := validateAttributeGroupData(name, slug, sortOrder); err != nil {
+		return err
+	}
+
+	g.Name = name
+	g.Slug = slug
+	g.SortOrder = sortOrder
+	g.ModifiedAt = time.Now().UTC()
+
+	return nil
+}
+
+func validateAttributeGroupData(name string, slug string, sortOrder int) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+
+	if len(name) > 100 {
+		return errors.New("name is too long (max 100 characters)")
+	}
+
+	if slug == "" {
+		return errors.New("slug is required")
+	}
+
+	if len(slug) > 50 {
+		return errors.New("slug is too long (max 50 characters)")
+	}
+
+	if !slugRegex.MatchString(slug) {
+		return errors.New("slug must contain only lowercase letters, numbers, and hyphens")
+	}
+
+	if sortOrder < 0 {
+		return errors.New("sortOrder cannot be negative")
+	}
+
+	return nil
+}
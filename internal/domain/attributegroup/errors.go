@@ -0,0 +1,9 @@
+package attributegroup
+
+import "errors"
+
+var (
+	ErrSlugAlreadyExists = errors.New("attribute group with this slug already exists")
+	ErrGroupInUse        = errors.New("attribute group is referenced by category attributes")
+	ErrGroupNotFound     = errors.New("attribute group not found")
+)
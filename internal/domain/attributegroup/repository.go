@@ -0,0 +1,33 @@
+package attributegroup
+
+import (
+	"context"
+
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+)
+
+type ListQuery struct {
+	Page  int
+	Size  int
+	Sort  string
+	Order string
+}
+
+type Repository interface {
+	Insert(ctx context.Context, group *AttributeGroup) error
+
+	FindByID(ctx context.Context, id string) (*AttributeGroup, error)
+
+	// FindByIDs returns the groups that exist among the given IDs, in no particular order
+	FindByIDs(ctx context.Context, ids []string) ([]*AttributeGroup, error)
+
+	FindList(ctx context.Context, query ListQuery) (*commonsmongo.PageResult[AttributeGroup], error)
+
+	Update(ctx context.Context, group *AttributeGroup) (*AttributeGroup, error)
+
+	Exists(ctx context.Context, id string) (bool, error)
+
+	// Delete removes the group if its version matches.
+	// Returns persistence.ErrEntityNotFound or persistence.ErrOptimisticLocking otherwise.
+	Delete(ctx context.Context, id string, version int) error
+}
@@ -2,6 +2,8 @@ package categoryattribute
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,17 +11,22 @@ import (
 
 // CategoryAttribute represents an assignment of an attribute to a category
 type CategoryAttribute struct {
-	ID          string
-	Version     int
-	CategoryID  string
-	AttributeID string
-	Required    bool
-	SortOrder   int
-	Filterable  *bool // nil means use attribute default
-	Searchable  *bool // nil means use attribute default
-	Enabled     bool
-	CreatedAt   time.Time
-	ModifiedAt  time.Time
+	ID               string
+	Version          int
+	CategoryID       string
+	AttributeID      string
+	Required         bool
+	SortOrder        int
+	Filterable       *bool // nil means use attribute default
+	Searchable       *bool // nil means use attribute default
+	Enabled          bool
+	CategoryDisabled bool     // set when the category service disables the category, Enabled stays the merchant's choice
+	GroupID          *string  // nil means the attribute is not grouped
+	GroupSortOrder   int      // order within the group
+	AllowedOptions   []string // option slugs allowed in the category, nil allows all options
+	DefaultValues    []string // values pre-filled for new products, nil means no default
+	CreatedAt        time.Time
+	ModifiedAt       time.Time
 }
 
 // NewCategoryAttribute creates a new category-attribute assignment with validation
@@ -32,28 +39,48 @@ func NewCategoryAttribute(
 	filterable *bool,
 	searchable *bool,
 	enabled bool,
+	groupID *string,
+	groupSortOrder int,
+	allowedOptions []string,
+	defaultValues []string,
 ) (*CategoryAttribute, error) {
 	if err := validateCategoryAttributeData(categoryID, attributeID, sortOrder); err != nil {
 		return nil, err
 	}
 
+	if err := validateGroup(groupID, groupSortOrder); err != nil {
+		return nil, err
+	}
+
+	if err := validateAllowedOptions(allowedOptions); err != nil {
+		return nil, err
+	}
+
+	if err := validateDefaultValues(defaultValues); err != nil {
+		return nil, err
+	}
+
 	if id == "" {
 		id = uuid.New().String()
 	}
 
 	now := time.Now().UTC()
 	return &CategoryAttribute{
-		ID:          id,
-		Version:     1,
-		CategoryID:  categoryID,
-		AttributeID: attributeID,
-		Required:    required,
-		SortOrder:   sortOrder,
-		Filterable:  filterable,
-		Searchable:  searchable,
-		Enabled:     enabled,
-		CreatedAt:   now,
-		ModifiedAt:  now,
+		ID:             id,
+		Version:        1,
+		CategoryID:     categoryID,
+		AttributeID:    attributeID,
+		Required:       required,
+		SortOrder:      sortOrder,
+		Filterable:     filterable,
+		Searchable:     searchable,
+		Enabled:        enabled,
+		GroupID:        groupID,
+		GroupSortOrder: groupSortOrder,
+		AllowedOptions: allowedOptions,
+		DefaultValues:  defaultValues,
+		CreatedAt:      now,
+		ModifiedAt:     now,
 	}, nil
 }
 
@@ -68,21 +95,31 @@ func Reconstruct(
 	filterable *bool,
 	searchable *bool,
 	enabled bool,
+	categoryDisabled bool,
+	groupID *string,
+	groupSortOrder int,
+	allowedOptions []string,
+	defaultValues []string,
 	createdAt time.Time,
 	modifiedAt time.Time,
 ) *CategoryAttribute {
 	return &CategoryAttribute{
-		ID:          id,
-		Version:     version,
-		CategoryID:  categoryID,
-		AttributeID: attributeID,
-		Required:    required,
-		SortOrder:   sortOrder,
-		Filterable:  filterable,
-		Searchable:  searchable,
-		Enabled:     enabled,
-		CreatedAt:   createdAt,
-		ModifiedAt:  modifiedAt,
+		ID:               id,
+		Version:          version,
+		CategoryID:       categoryID,
+		AttributeID:      attributeID,
+		Required:         required,
+		SortOrder:        sortOrder,
+		Filterable:       filterable,
+		Searchable:       searchable,
+		Enabled:          enabled,
+		CategoryDisabled: categoryDisabled,
+		GroupID:          groupID,
+		GroupSortOrder:   groupSortOrder,
+		AllowedOptions:   allowedOptions,
+		DefaultValues:    defaultValues,
+		CreatedAt:        createdAt,
+		ModifiedAt:       modifiedAt,
 	}
 }
 
@@ -93,21 +130,166 @@ func (ca *CategoryAttribute) Update(
 	filterable *bool,
 	searchable *bool,
 	enabled bool,
+	groupID *string,
+	groupSortOrder int,
+	allowedOptions []string,
+	defaultValues []string,
 ) error {
 	if sortOrder < 0 {
 		return errors.New("sortOrder cannot be negative")
 	}
 
+	if err := validateGroup(groupID, groupSortOrder); err != nil {
+		return err
+	}
+
+	if err := validateAllowedOptions(allowedOptions); err != nil {
+		return err
+	}
+
+	if err := validateDefaultValues(defaultValues); err != nil {
+		return err
+	}
+
 	ca.Required = required
 	ca.SortOrder = sortOrder
 	ca.Filterable = filterable
 	ca.Searchable = searchable
 	ca.Enabled = enabled
+	ca.GroupID = groupID
+	ca.GroupSortOrder = groupSortOrder
+	ca.AllowedOptions = allowedOptions
+	ca.DefaultValues = defaultValues
 	ca.ModifiedAt = time.Now().UTC()
 
 	return nil
 }
 
+// EffectiveFilterable resolves the filterable flag against the attribute default
+func (ca *CategoryAttribute) EffectiveFilterable(attributeDefault bool) bool {
+	if ca.Filterable != nil {
+		return *ca.Filterable
+	}
+	return attributeDefault
+}
+
+// EffectiveSearchable resolves the searchable flag against the attribute default
+func (ca *CategoryAttribute) EffectiveSearchable(attributeDefault bool) bool {
+	if ca.Searchable != nil {
+		return *ca.Searchable
+	}
+	return attributeDefault
+}
+
+// AllowsOption reports whether products of the category may use the option
+func (ca *CategoryAttribute) AllowsOption(slug string) bool {
+	return ca.AllowedOptions == nil || slices.Contains(ca.AllowedOptions, slug)
+}
+
+// ReplaceOptionSlug points allowed options and default values at the new slug of a
+// renamed or merged option, reporting whether anything changed
+func (ca *CategoryAttribute) ReplaceOptionSlug(slug string, newSlug string) bool {
+	allowed, allowedChanged := replaceSlug(ca.AllowedOptions, slug, newSlug)
+	defaults, defaultsChanged := replaceSlug(ca.DefaultValues, slug, newSlug)
+	if !allowedChanged && !defaultsChanged {
+		return false
+	}
+
+	ca.AllowedOptions = allowed
+	ca.DefaultValues = defaults
+	ca.ModifiedAt = time.Now().UTC()
+
+	return true
+}
+
+// RetainAllowedOptions drops the allowed options keep rejects, e.g. options removed from
+// the attribute, reporting whether anything changed. Dropping every allowed option fails
+// with ErrNoAllowedOption, as no restriction at all would allow every option instead.
+func (ca *CategoryAttribute) RetainAllowedOptions(keep func(slug string) bool) (bool, error) {
+	if ca.AllowedOptions == nil {
+		return false, nil
+	}
+
+	retained := slices.DeleteFunc(slices.Clone(ca.AllowedOptions), func(slug string) bool {
+		return !keep(slug)
+	})
+	if len(retained) == len(ca.AllowedOptions) {
+		return false, nil
+	}
+	if len(retained) == 0 {
+		return false, ErrNoAllowedOption
+	}
+
+	ca.AllowedOptions = retained
+	ca.ModifiedAt = time.Now().UTC()
+
+	return true, nil
+}
+
+// ReplaceDefaultValues stores values as the defaults, e.g. the defaults that survived an
+// attribute update, reporting whether anything changed. No values leave no default.
+func (ca *CategoryAttribute) ReplaceDefaultValues(values []string) bool {
+	if len(values) == 0 {
+		values = nil
+	}
+	if slices.Equal(ca.DefaultValues, values) {
+		return false
+	}
+
+	ca.DefaultValues = values
+	ca.ModifiedAt = time.Now().UTC()
+
+	return true
+}
+
+// MoveTo changes the position of the attribute in the category, reporting whether anything changed
+func (ca *CategoryAttribute) MoveTo(sortOrder int) bool {
+	if ca.SortOrder == sortOrder {
+		return false
+	}
+
+	ca.SortOrder = sortOrder
+	ca.ModifiedAt = time.Now().UTC()
+
+	return true
+}
+
+// IsActive reports whether products of the category use the attribute:
+// the assignment is enabled and its category is not disabled
+func (ca *CategoryAttribute) IsActive() bool {
+	return ca.Enabled && !ca.CategoryDisabled
+}
+
+// MarkCategoryDisabled records that the category was disabled, reporting whether anything changed
+func (ca *CategoryAttribute) MarkCategoryDisabled() bool {
+	if ca.CategoryDisabled {
+		return false
+	}
+
+	ca.CategoryDisabled = true
+	ca.ModifiedAt = time.Now().UTC()
+
+	return true
+}
+
+// replaceSlug swaps slug for newSlug, dropping the duplicate left behind when both were listed
+func replaceSlug(slugs []string, slug string, newSlug string) ([]string, bool) {
+	if !slices.Contains(slugs, slug) {
+		return slugs, false
+	}
+
+	replaced := make([]string, 0, len(slugs))
+	for _, s := range slugs {
+		if s == slug {
+			s = newSlug
+		}
+		if !slices.Contains(replaced, s) {
+			replaced = append(replaced, s)
+		}
+	}
+	return replaced, true
+}
+
 func validateCategoryAttributeData(categoryID string, attributeID string, sortOrder int) error {
 	if categoryID == "" {
 		return errors.New("categoryID is required")
@@ -123,3 +305,43 @@ func validateCategoryAttributeData(categoryID string, attributeID string, sortOr
 
 	return nil
 }
+
+func validateGroup(groupID *string, groupSortOrder int) error {
+	if groupID != nil && *groupID == "" {
+		return errors.New("groupID cannot be empty")
+	}
+
+	if groupSortOrder < 0 {
+		return errors.New("groupSortOrder cannot be negative")
+	}
+
+	return nil
+}
+
+func validateAllowedOptions(allowedOptions []string) error {
+	if allowedOptions != nil && len(allowedOptions) == 0 {
+		return errors.New("allowedOptions cannot be empty, omit it to allow all options")
+	}
+
+	seen := make(map[string]bool, len(allowedOptions))
+	for _, slug := range allowedOptions {
+		if slug == "" {
+			return errors.New("allowed option slug cannot be empty")
+		}
+		if seen[slug] {
+			return fmt.Errorf("allowed option %q is repeated", slug)
+		}
+		seen[slug] = true
+	}
+
+	return nil
+}
+
+// validateDefaultValues checks the shape of default values; whether they suit
+// the attribute type and options is checked against the attribute by the caller
+func validateDefaultValues(defaultValues []string) error {
+	if defaultValues != nil && len(defaultValues) == 0 {
+		return errors.New("defaultValues cannot be empty, omit it to have no default")
+	}
+	return nil
+}
@@ -4,4 +4,7 @@ import "errors"
 
 var (
 	ErrAlreadyAssigned = errors.New("attribute is already assigned to this category")
+	ErrSameCategory    = errors.New("source and target categories must differ")
+	ErrInvalidDefault  = errors.New("default value does not suit the attribute")
+	ErrNoAllowedOption = errors.New("no allowed option of the category would remain")
 )
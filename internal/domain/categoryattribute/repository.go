@@ -11,9 +11,12 @@ type ListQuery struct {
 	Page       int
 	Size       int
 	Enabled    *bool
-	Filterable *bool
-	Sort       string
-	Order      string
+	Filterable *bool // matches the effective flag, see FilterableByDefault
+	// FilterableByDefault lists attributes whose default flag equals Filterable;
+	// their assignments without an override match as well
+	FilterableByDefault []string
+	Sort                string
+	Order               string
 }
 
 type Repository interface {
@@ -28,4 +31,16 @@ type Repository interface {
 	Update(ctx context.Context, ca *CategoryAttribute) (*CategoryAttribute, error)
 
 	Delete(ctx context.Context, id string) error
+
+	FindAllByAttributeID(ctx context.Context, attributeID string) ([]*CategoryAttribute, error)
+
+	DeleteByAttributeID(ctx context.Context, attributeID string) error
+
+	FindAllByCategoryID(ctx context.Context, categoryID string) ([]*CategoryAttribute, error)
+
+	FindAllByCategoryIDs(ctx context.Context, categoryIDs []string) ([]*CategoryAttribute, error)
+
+	DeleteByCategoryID(ctx context.Context, categoryID string) error
+
+	ExistsByGroupID(ctx context.Context, groupID string) (bool, error)
 }
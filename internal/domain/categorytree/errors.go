@@ -0,0 +1,7 @@
+package categorytree
+
+import "errors"
+
+var (
+	ErrCyclicParent = errors.New("category cannot be its own ancestor")
+)
@@ -0,0 +1,139 @@
+package categorytree
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// MaxDepth bounds ancestor walks so a corrupted tree cannot loop forever
+const MaxDepth = 32
+
+// Node is this service's view of a category in the category tree.
+// The parent relation is mirrored from category service events, while
+// exclusions of inherited attributes are managed by this service.
+type Node struct {
+	ID                   string // category ID
+	Version              int
+	ParentID             *string // nil for root categories
+	CategoryVersion      int     // category service version of the last applied event
+	Deleted              bool    // tombstone of a category deleted in the category service
+	ExcludedAttributeIDs []string
+	CreatedAt            time.Time
+	ModifiedAt           time.Time
+}
+
+// NewNode creates a root node for a category
+func NewNode(categoryID string) (*Node, error) {
+	if categoryID == "" {
+		return nil, errors.New("categoryID is required")
+	}
+
+	now := time.Now().UTC()
+	return &Node{
+		ID:         categoryID,
+		Version:    1,
+		CreatedAt:  now,
+		ModifiedAt: now,
+	}, nil
+}
+
+// Reconstruct rebuilds a node from persistence (no validation)
+func Reconstruct(
+	id string,
+	version int,
+	parentID *string,
+	categoryVersion int,
+	deleted bool,
+	excludedAttributeIDs []string,
+	createdAt time.Time,
+	modifiedAt time.Time,
+) *Node {
+	return &Node{
+		ID:                   id,
+		Version:              version,
+		ParentID:             parentID,
+		CategoryVersion:      categoryVersion,
+		Deleted:              deleted,
+		ExcludedAttributeIDs: excludedAttributeIDs,
+		CreatedAt:            createdAt,
+		ModifiedAt:           modifiedAt,
+	}
+}
+
+// SetParent applies the parent relation of the given category version.
+// Returns false when the version is not newer than the applied one,
+// which keeps redelivered events from reviving a tombstone.
+func (n *Node) SetParent(parentID *string, categoryVersion int) (bool, error) {
+	if categoryVersion <= n.CategoryVersion {
+		return false, nil
+	}
+
+	if parentID != nil && *parentID == n.ID {
+		return false, ErrCyclicParent
+	}
+
+	n.ParentID = parentID
+	n.CategoryVersion = categoryVersion
+	n.Deleted = false
+	n.ModifiedAt = time.Now().UTC()
+
+	return true, nil
+}
+
+// MarkDeleted turns the node into a tombstone of a deleted category.
+// The tombstone keeps the newest category version seen, so older events are ignored afterwards.
+// Returns false if the node is a tombstone already.
+func (n *Node) MarkDeleted(categoryVersion int) bool {
+	if n.Deleted {
+		return false
+	}
+
+	n.Deleted = true
+	n.ParentID = nil
+	n.ExcludedAttributeIDs = nil
+	n.CategoryVersion = max(n.CategoryVersion, categoryVersion)
+	n.ModifiedAt = time.Now().UTC()
+
+	return true
+}
+
+// Detach makes the node a root, e.g. after its parent category was deleted.
+// The category version is kept so the next event of the category still applies.
+func (n *Node) Detach() {
+	n.ParentID = nil
+	n.ModifiedAt = time.Now().UTC()
+}
+
+// Exclude hides an attribute inherited from ancestors. Returns false if it was already excluded.
+func (n *Node) Exclude(attributeID string) (bool, error) {
+	if attributeID == "" {
+		return false, errors.New("attributeID is required")
+	}
+
+	if n.IsExcluded(attributeID) {
+		return false, nil
+	}
+
+	n.ExcludedAttributeIDs = append(n.ExcludedAttributeIDs, attributeID)
+	n.ModifiedAt = time.Now().UTC()
+
+	return true, nil
+}
+
+// Include lifts an exclusion. Returns false if the attribute was not excluded.
+func (n *Node) Include(attributeID string) bool {
+	idx := slices.Index(n.ExcludedAttributeIDs, attributeID)
+	if idx < 0 {
+		return false
+	}
+
+	n.ExcludedAttributeIDs = slices.Delete(n.ExcludedAttributeIDs, idx, idx+1)
+	n.ModifiedAt = time.Now().UTC()
+
+	return true
+}
+
+func (n *Node) IsExcluded(attributeID string) bool {
+	return slices.Contains(n.ExcludedAttributeIDs, attributeID)
+}
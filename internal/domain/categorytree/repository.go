@@ -0,0 +1,21 @@
+package categorytree
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Insert(ctx context.Context, node *Node) error
+
+	FindByID(ctx context.Context, id string) (*Node, error)
+
+	// FindPath returns the node of the category followed by its ancestors, nearest first.
+	// The walk stops at a root, a node that is not known yet, a tombstone, a cycle or MaxDepth.
+	// Returns an empty slice when the category has no node or a tombstone.
+	FindPath(ctx context.Context, id string) ([]*Node, error)
+
+	// FindChildren returns the nodes whose parent is the category, in no particular order
+	FindChildren(ctx context.Context, parentID string) ([]*Node, error)
+
+	Update(ctx context.Context, node *Node) (*Node, error)
+}
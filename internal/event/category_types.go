@@ -0,0 +1,69 @@
+package event
+
+import (
+	"time"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/events"
+)
+
+// Category events are owned by the category service. The types below mirror
+// its published schemas for the events this service consumes.
+
+// CategoryCreatedPayload is the business data of CategoryCreatedEvent.
+type CategoryCreatedPayload struct {
+	CategoryID string    `avro:"category_id" json:"category_id"`
+	ParentID   *string   `avro:"parent_id" json:"parent_id,omitempty"`
+	Version    int       `avro:"version" json:"version"`
+	CreatedAt  time.Time `avro:"created_at" json:"created_at"`
+}
+
+// CategoryCreatedEvent is consumed when a category is created.
+type CategoryCreatedEvent struct {
+	Metadata events.EventMetadata   `avro:"metadata" json:"metadata"`
+	Payload  CategoryCreatedPayload `avro:"payload" json:"payload"`
+}
+
+// CategoryUpdatedPayload is the business data of CategoryUpdatedEvent.
+type CategoryUpdatedPayload struct {
+	CategoryID string    `avro:"category_id" json:"category_id"`
+	ParentID   *string   `avro:"parent_id" json:"parent_id,omitempty"`
+	Version    int       `avro:"version" json:"version"`
+	ModifiedAt time.Time `avro:"modified_at" json:"modified_at"`
+}
+
+// CategoryUpdatedEvent is consumed when a category is updated, including moves in the tree.
+type CategoryUpdatedEvent struct {
+	Metadata events.EventMetadata   `avro:"metadata" json:"metadata"`
+	Payload  CategoryUpdatedPayload `avro:"payload" json:"payload"`
+}
+
+// CategoryDeletedPayload is the business data of CategoryDeletedEvent.
+type CategoryDeletedPayload struct {
+	CategoryID string    `avro:"category_id" json:"category_id"`
+	Version    int       `avro:"version" json:"version"`
+	DeletedAt  time.Time `avro:"deleted_at" json:"deleted_at"`
+}
+
+// CategoryDeletedEvent is consumed when a category is deleted.
+type CategoryDeletedEvent struct {
+	Metadata events.EventMetadata   `avro:"metadata" json:"metadata"`
+	Payload  CategoryDeletedPayload `avro:"payload" json:"payload"`
+}
+
+// CategoryDisabledPayload is the business data of CategoryDisabledEvent.
+type CategoryDisabledPayload struct {
+	CategoryID string    `avro:"category_id" json:"category_id"`
+	Version    int       `avro:"version" json:"version"`
+	DisabledAt time.Time `avro:"disabled_at" json:"disabled_at"`
+}
+
+// CategoryDisabledEvent is consumed when a category is disabled.
+type CategoryDisabledEvent struct {
+	Metadata events.EventMetadata    `avro:"metadata" json:"metadata"`
+	Payload  CategoryDisabledPayload `avro:"payload" json:"payload"`
+}
+
+func (e *CategoryCreatedEvent) GetMetadata() *events.EventMetadata  { return &e.Metadata }
+func (e *CategoryUpdatedEvent) GetMetadata() *events.EventMetadata  { return &e.Metadata }
+func (e *CategoryDeletedEvent) GetMetadata() *events.EventMetadata  { return &e.Metadata }
+func (e *CategoryDisabledEvent) GetMetadata() *events.EventMetadata { return &e.Metadata }
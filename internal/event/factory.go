@@ -0,0 +1,355 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/events"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/patterns/outbox"
+)
+
+const source = "ecommerce-attribute-service"
+
+// Factory builds outbox messages for attribute domain events
+type Factory interface {
+	NewAttributeCreatedOutboxMessage(ctx context.Context, a *attribute.Attribute) outbox.Message
+	NewAttributeUpdatedOutboxMessage(ctx context.Context, a *attribute.Attribute) outbox.Message
+	NewAttributeDeletedOutboxMessage(ctx context.Context, id string, version int) outbox.Message
+	NewAttributeOptionRenamedOutboxMessage(ctx context.Context, a *attribute.Attribute, oldSlug string, newSlug string) outbox.Message
+	NewAttributeOptionsMergedOutboxMessage(ctx context.Context, a *attribute.Attribute, sourceSlug string, targetSlug string) outbox.Message
+	NewCategoryAttributeAssignedOutboxMessage(ctx context.Context, ca *categoryattribute.CategoryAttribute) outbox.Message
+	NewCategoryAttributeUpdatedOutboxMessage(ctx context.Context, ca *categoryattribute.CategoryAttribute) outbox.Message
+	NewCategoryAttributeUnassignedOutboxMessage(ctx context.Context, ca *categoryattribute.CategoryAttribute) outbox.Message
+	NewCategoryAttributesBulkUpdatedOutboxMessage(ctx context.Context, categoryID string, mode string, assignments []*categoryattribute.CategoryAttribute, unassigned []*categoryattribute.CategoryAttribute) outbox.Message
+}
+
+type factory struct{}
+
+func newFactory() Factory {
+	return &factory{}
+}
+
+func (f *factory) NewAttributeCreatedOutboxMessage(ctx context.Context, a *attribute.Attribute) outbox.Message {
+	e := &AttributeCreatedEvent{
+		Metadata: newMetadata(ctx, EventTypeAttributeCreated),
+		Payload: AttributeCreatedPayload{
+			AttributeID:   a.ID,
+			Name:          a.Name,
+			Slug:          a.Slug,
+			PreviousSlugs: a.PreviousSlugs,
+			Status:        string(a.Status),
+			Type:          string(a.Type),
+			Unit:          a.Unit,
+			Enabled:       a.Enabled,
+			Filterable:    a.Filterable,
+			Searchable:    a.Searchable,
+			Options:       lo.Map(a.Options, toOptionPayload),
+			Range:         toRangePayload(a.TypeConfig.Range),
+			Date:          toDatePayload(a.TypeConfig.Date),
+			Dimension:     toDimensionPayload(a.TypeConfig.Dimension),
+			Reference:     toReferencePayload(a.TypeConfig.Reference),
+			Text:          toTextPayload(a.TypeConfig.Text),
+			SwatchMode:    string(a.TypeConfig.SwatchMode),
+			Translations:  lo.MapValues(a.Translations, toTranslationPayload),
+			Version:       a.Version,
+			CreatedAt:     a.CreatedAt,
+			ModifiedAt:    a.ModifiedAt,
+		},
+	}
+	return newMessage(e, a.ID)
+}
+
+func (f *factory) NewAttributeUpdatedOutboxMessage(ctx context.Context, a *attribute.Attribute) outbox.Message {
+	e := &AttributeUpdatedEvent{
+		Metadata: newMetadata(ctx, EventTypeAttributeUpdated),
+		Payload: AttributeUpdatedPayload{
+			AttributeID:   a.ID,
+			Name:          a.Name,
+			Slug:          a.Slug,
+			PreviousSlugs: a.PreviousSlugs,
+			Status:        string(a.Status),
+			Type:          string(a.Type),
+			Unit:          a.Unit,
+			Enabled:       a.Enabled,
+			Filterable:    a.Filterable,
+			Searchable:    a.Searchable,
+			Options:       lo.Map(a.Options, toOptionPayload),
+			Range:         toRangePayload(a.TypeConfig.Range),
+			Date:          toDatePayload(a.TypeConfig.Date),
+			Dimension:     toDimensionPayload(a.TypeConfig.Dimension),
+			Reference:     toReferencePayload(a.TypeConfig.Reference),
+			Text:          toTextPayload(a.TypeConfig.Text),
+			SwatchMode:    string(a.TypeConfig.SwatchMode),
+			Translations:  lo.MapValues(a.Translations, toTranslationPayload),
+			Version:       a.Version,
+			ModifiedAt:    a.ModifiedAt,
+		},
+	}
+	return newMessage(e, a.ID)
+}
+
+func (f *factory) NewAttributeDeletedOutboxMessage(ctx context.Context, id string, version int) outbox.Message {
+	e := &AttributeDeletedEvent{
+		Metadata: newMetadata(ctx, EventTypeAttributeDeleted),
+		Payload: AttributeDeletedPayload{
+			AttributeID: id,
+			Version:     version,
+			DeletedAt:   time.Now().UTC(),
+		},
+	}
+	return newMessage(e, id)
+}
+
+func (f *factory) NewAttributeOptionRenamedOutboxMessage(ctx context.Context, a *attribute.Attribute, oldSlug string, newSlug string) outbox.Message {
+	e := &AttributeOptionRenamedEvent{
+		Metadata: newMetadata(ctx, EventTypeAttributeOptionRenamed),
+		Payload: AttributeOptionRenamedPayload{
+			AttributeID: a.ID,
+			OldSlug:     oldSlug,
+			NewSlug:     newSlug,
+			Version:     a.Version,
+			ModifiedAt:  a.ModifiedAt,
+		},
+	}
+	return newMessage(e, a.ID)
+}
+
+func (f *factory) NewAttributeOptionsMergedOutboxMessage(ctx context.Context, a *attribute.Attribute, sourceSlug string, targetSlug string) outbox.Message {
+	e := &AttributeOptionsMergedEvent{
+		Metadata: newMetadata(ctx, EventTypeAttributeOptionsMerged),
+		Payload: AttributeOptionsMergedPayload{
+			AttributeID: a.ID,
+			SourceSlug:  sourceSlug,
+			TargetSlug:  targetSlug,
+			Version:     a.Version,
+			ModifiedAt:  a.ModifiedAt,
+		},
+	}
+	return newMessage(e, a.ID)
+}
+
+func (f *factory) NewCategoryAttributeAssignedOutboxMessage(ctx context.Context, ca *categoryattribute.CategoryAttribute) outbox.Message {
+	e := &CategoryAttributeAssignedEvent{
+		Metadata: newMetadata(ctx, EventTypeCategoryAttributeAssigned),
+		Payload: CategoryAttributeAssignedPayload{
+			CategoryAttributeID: ca.ID,
+			CategoryID:          ca.CategoryID,
+			AttributeID:         ca.AttributeID,
+			Required:            ca.Required,
+			SortOrder:           ca.SortOrder,
+			Filterable:          ca.Filterable,
+			Searchable:          ca.Searchable,
+			Enabled:             ca.Enabled,
+			CategoryDisabled:    ca.CategoryDisabled,
+			GroupID:             ca.GroupID,
+			GroupSortOrder:      ca.GroupSortOrder,
+			AllowedOptions:      ca.AllowedOptions,
+			DefaultValues:       ca.DefaultValues,
+			Version:             ca.Version,
+			CreatedAt:           ca.CreatedAt,
+			ModifiedAt:          ca.ModifiedAt,
+		},
+	}
+	return newMessage(e, ca.CategoryID)
+}
+
+func (f *factory) NewCategoryAttributeUpdatedOutboxMessage(ctx context.Context, ca *categoryattribute.CategoryAttribute) outbox.Message {
+	e := &CategoryAttributeUpdatedEvent{
+		Metadata: newMetadata(ctx, EventTypeCategoryAttributeUpdated),
+		Payload: CategoryAttributeUpdatedPayload{
+			CategoryAttributeID: ca.ID,
+			CategoryID:          ca.CategoryID,
+			AttributeID:         ca.AttributeID,
+			Required:            ca.Required,
+			SortOrder:           ca.SortOrder,
+			Filterable:          ca.Filterable,
+			Searchable:          ca.Searchable,
+			Enabled:             ca.Enabled,
+			CategoryDisabled:    ca.CategoryDisabled,
+			GroupID:             ca.GroupID,
+			GroupSortOrder:      ca.GroupSortOrder,
+			AllowedOptions:      ca.AllowedOptions,
+			DefaultValues:       ca.DefaultValues,
+			Version:             ca.Version,
+			ModifiedAt:          ca.ModifiedAt,
+		},
+	}
+	return newMessage(e, ca.CategoryID)
+}
+
+func (f *factory) NewCategoryAttributeUnassignedOutboxMessage(ctx context.Context, ca *categoryattribute.CategoryAttribute) outbox.Message {
+	e := &CategoryAttributeUnassignedEvent{
+		Metadata: newMetadata(ctx, EventTypeCategoryAttributeUnassigned),
+		Payload: CategoryAttributeUnassignedPayload{
+			CategoryAttributeID: ca.ID,
+			CategoryID:          ca.CategoryID,
+			AttributeID:         ca.AttributeID,
+		},
+	}
+	return newMessage(e, ca.CategoryID)
+}
+
+func (f *factory) NewCategoryAttributesBulkUpdatedOutboxMessage(
+	ctx context.Context,
+	categoryID string,
+	mode string,
+	assignments []*categoryattribute.CategoryAttribute,
+	unassigned []*categoryattribute.CategoryAttribute,
+) outbox.Message {
+	e := &CategoryAttributesBulkUpdatedEvent{
+		Metadata: newMetadata(ctx, EventTypeCategoryAttributesBulkUpdated),
+		Payload: CategoryAttributesBulkUpdatedPayload{
+			CategoryID:  categoryID,
+			Mode:        mode,
+			Assignments: lo.Map(assignments, toCategoryAttributeSnapshot),
+			Unassigned: lo.Map(unassigned, func(ca *categoryattribute.CategoryAttribute, _ int) UnassignedCategoryAttribute {
+				return UnassignedCategoryAttribute{
+					CategoryAttributeID: ca.ID,
+					AttributeID:         ca.AttributeID,
+				}
+			}),
+			ModifiedAt: time.Now().UTC(),
+		},
+	}
+	return newMessage(e, categoryID)
+}
+
+func newMetadata(ctx context.Context, eventType string) events.EventMetadata {
+	var traceID *string
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		traceID = lo.ToPtr(sc.TraceID().String())
+	}
+
+	return events.EventMetadata{
+		EventID:   uuid.NewString(),
+		EventType: eventType,
+		Source:    source,
+		Timestamp: time.Now().UTC(),
+		TraceID:   traceID,
+	}
+}
+
+// newMessage wraps an event into an outbox message; key keeps per-entity ordering in Kafka
+func newMessage(e events.Event, key string) outbox.Message {
+	metadata := e.GetMetadata()
+	return outbox.Message{
+		Payload: e,
+		EventID: metadata.EventID,
+		Key:     key,
+		Headers: map[string]string{"event_type": metadata.EventType},
+	}
+}
+
+func toOptionPayload(opt attribute.Option, _ int) AttributeOptionPayload {
+	return AttributeOptionPayload{
+		Name:          opt.Name,
+		Slug:          opt.Slug,
+		ColorCode:     opt.ColorCode,
+		Image:         toOptionImagePayload(opt.Image),
+		SortOrder:     opt.SortOrder,
+		Enabled:       opt.Enabled,
+		Translations:  opt.Translations,
+		PreviousSlugs: opt.PreviousSlugs,
+	}
+}
+
+func toOptionImagePayload(image *attribute.OptionImage) *AttributeOptionImagePayload {
+	if image == nil {
+		return nil
+	}
+	return &AttributeOptionImagePayload{
+		URL: image.URL,
+		Alt: image.Alt,
+	}
+}
+
+func toCategoryAttributeSnapshot(ca *categoryattribute.CategoryAttribute, _ int) CategoryAttributeSnapshot {
+	return CategoryAttributeSnapshot{
+		CategoryAttributeID: ca.ID,
+		CategoryID:          ca.CategoryID,
+		AttributeID:         ca.AttributeID,
+		Required:            ca.Required,
+		SortOrder:           ca.SortOrder,
+		Filterable:          ca.Filterable,
+		Searchable:          ca.Searchable,
+		Enabled:             ca.Enabled,
+		CategoryDisabled:    ca.CategoryDisabled,
+		GroupID:             ca.GroupID,
+		GroupSortOrder:      ca.GroupSortOrder,
+		AllowedOptions:      ca.AllowedOptions,
+		DefaultValues:       ca.DefaultValues,
+		Version:             ca.Version,
+		CreatedAt:           ca.CreatedAt,
+		ModifiedAt:          ca.ModifiedAt,
+	}
+}
+
+func toRangePayload(cfg *attribute.RangeConfig) *AttributeRangePayload {
+	if cfg == nil {
+		return nil
+	}
+	return &AttributeRangePayload{
+		Min:       cfg.Min,
+		Max:       cfg.Max,
+		Step:      cfg.Step,
+		Precision: cfg.Precision,
+	}
+}
+
+func toDatePayload(cfg *attribute.DateConfig) *AttributeDatePayload {
+	if cfg == nil {
+		return nil
+	}
+	return &AttributeDatePayload{
+		Min: cfg.Min,
+		Max: cfg.Max,
+	}
+}
+
+func toDimensionPayload(cfg *attribute.DimensionConfig) *AttributeDimensionPayload {
+	if cfg == nil {
+		return nil
+	}
+	return &AttributeDimensionPayload{
+		Components: lo.Map(cfg.Components, func(c attribute.DimensionComponent, _ int) AttributeDimensionComponentPayload {
+			return AttributeDimensionComponentPayload{
+				Key:  c.Key,
+				Unit: c.Unit,
+				Min:  c.Min,
+				Max:  c.Max,
+			}
+		}),
+	}
+}
+
+func toReferencePayload(cfg *attribute.ReferenceConfig) *AttributeReferencePayload {
+	if cfg == nil {
+		return nil
+	}
+	return &AttributeReferencePayload{TargetKind: cfg.TargetKind}
+}
+
+func toTextPayload(cfg *attribute.TextConfig) *AttributeTextPayload {
+	if cfg == nil {
+		return nil
+	}
+	return &AttributeTextPayload{
+		MinLength: cfg.MinLength,
+		MaxLength: cfg.MaxLength,
+		Pattern:   cfg.Pattern,
+		Multiline: cfg.Multiline,
+	}
+}
+
+func toTranslationPayload(t attribute.Translation, _ string) AttributeTranslationPayload {
+	return AttributeTranslationPayload{
+		Name: t.Name,
+		Unit: t.Unit,
+	}
+}
@@ -0,0 +1,19 @@
+package event
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/avro/mapping"
+)
+
+// Module registers attribute event schemas and provides the event factory
+func Module() fx.Option {
+	return fx.Options(
+		fx.Provide(newFactory),
+		fx.Invoke(registerSchemaBindings),
+	)
+}
+
+func registerSchemaBindings(typeMapping *mapping.TypeMapping) error {
+	return typeMapping.RegisterBindings(SchemaBindings)
+}
@@ -0,0 +1,166 @@
+package event
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/avro/mapping"
+)
+
+// Event type constants - match Avro schema names
+const (
+	EventTypeAttributeCreated              = "AttributeCreatedEvent"
+	EventTypeAttributeUpdated              = "AttributeUpdatedEvent"
+	EventTypeAttributeDeleted              = "AttributeDeletedEvent"
+	EventTypeAttributeOptionRenamed        = "AttributeOptionRenamedEvent"
+	EventTypeAttributeOptionsMerged        = "AttributeOptionsMergedEvent"
+	EventTypeCategoryAttributeAssigned     = "CategoryAttributeAssignedEvent"
+	EventTypeCategoryAttributeUpdated      = "CategoryAttributeUpdatedEvent"
+	EventTypeCategoryAttributeUnassigned   = "CategoryAttributeUnassignedEvent"
+	EventTypeCategoryAttributesBulkUpdated = "CategoryAttributesBulkUpdatedEvent"
+)
+
+// TopicCatalogAttributeEvents is the Kafka topic for all attribute domain events
+const TopicCatalogAttributeEvents = "catalog.attribute.events"
+
+// TopicCatalogCategoryEvents is the Kafka topic the category service publishes to
+const TopicCatalogCategoryEvents = "catalog.category.events"
+
+// Schema name constants - Avro schema full names (namespace.name)
+const (
+	SchemaNameAttributeCreated              = "com.ecommerce.events.attribute.AttributeCreatedEvent"
+	SchemaNameAttributeUpdated              = "com.ecommerce.events.attribute.AttributeUpdatedEvent"
+	SchemaNameAttributeDeleted              = "com.ecommerce.events.attribute.AttributeDeletedEvent"
+	SchemaNameAttributeOptionRenamed        = "com.ecommerce.events.attribute.AttributeOptionRenamedEvent"
+	SchemaNameAttributeOptionsMerged        = "com.ecommerce.events.attribute.AttributeOptionsMergedEvent"
+	SchemaNameCategoryAttributeAssigned     = "com.ecommerce.events.attribute.CategoryAttributeAssignedEvent"
+	SchemaNameCategoryAttributeUpdated      = "com.ecommerce.events.attribute.CategoryAttributeUpdatedEvent"
+	SchemaNameCategoryAttributeUnassigned   = "com.ecommerce.events.attribute.CategoryAttributeUnassignedEvent"
+	SchemaNameCategoryAttributesBulkUpdated = "com.ecommerce.events.attribute.CategoryAttributesBulkUpdatedEvent"
+
+	SchemaNameCategoryCreated  = "com.ecommerce.events.category.CategoryCreatedEvent"
+	SchemaNameCategoryUpdated  = "com.ecommerce.events.category.CategoryUpdatedEvent"
+	SchemaNameCategoryDeleted  = "com.ecommerce.events.category.CategoryDeletedEvent"
+	SchemaNameCategoryDisabled = "com.ecommerce.events.category.CategoryDisabledEvent"
+)
+
+//go:embed schemas/attribute_created.avsc
+var AttributeCreatedSchema []byte
+
+//go:embed schemas/attribute_updated.avsc
+var AttributeUpdatedSchema []byte
+
+//go:embed schemas/attribute_deleted.avsc
+var AttributeDeletedSchema []byte
+
+//go:embed schemas/attribute_option_renamed.avsc
+var AttributeOptionRenamedSchema []byte
+
+//go:embed schemas/attribute_options_merged.avsc
+var AttributeOptionsMergedSchema []byte
+
+//go:embed schemas/category_attribute_assigned.avsc
+var CategoryAttributeAssignedSchema []byte
+
+//go:embed schemas/category_attribute_updated.avsc
+var CategoryAttributeUpdatedSchema []byte
+
+//go:embed schemas/category_attribute_unassigned.avsc
+var CategoryAttributeUnassignedSchema []byte
+
+//go:embed schemas/category_attributes_bulk_updated.avsc
+var CategoryAttributesBulkUpdatedSchema []byte
+
+//go:embed schemas/category_created.avsc
+var CategoryCreatedSchema []byte
+
+//go:embed schemas/category_updated.avsc
+var CategoryUpdatedSchema []byte
+
+//go:embed schemas/category_deleted.avsc
+var CategoryDeletedSchema []byte
+
+//go:embed schemas/category_disabled.avsc
+var CategoryDisabledSchema []byte
+
+// SchemaBindings contains all event schema bindings for registration with TypeMapping
+var SchemaBindings = []mapping.SchemaBinding{
+	{
+		GoType:     reflect.TypeOf(AttributeCreatedEvent{}),
+		SchemaJSON: AttributeCreatedSchema,
+		SchemaName: SchemaNameAttributeCreated,
+		Topic:      TopicCatalogAttributeEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(AttributeUpdatedEvent{}),
+		SchemaJSON: AttributeUpdatedSchema,
+		SchemaName: SchemaNameAttributeUpdated,
+		Topic:      TopicCatalogAttributeEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(AttributeDeletedEvent{}),
+		SchemaJSON: AttributeDeletedSchema,
+		SchemaName: SchemaNameAttributeDeleted,
+		Topic:      TopicCatalogAttributeEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(AttributeOptionRenamedEvent{}),
+		SchemaJSON: AttributeOptionRenamedSchema,
+		SchemaName: SchemaNameAttributeOptionRenamed,
+		Topic:      TopicCatalogAttributeEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(AttributeOptionsMergedEvent{}),
+		SchemaJSON: AttributeOptionsMergedSchema,
+		SchemaName: SchemaNameAttributeOptionsMerged,
+		Topic:      TopicCatalogAttributeEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(CategoryAttributeAssignedEvent{}),
+		SchemaJSON: CategoryAttributeAssignedSchema,
+		SchemaName: SchemaNameCategoryAttributeAssigned,
+		Topic:      TopicCatalogAttributeEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(CategoryAttributeUpdatedEvent{}),
+		SchemaJSON: CategoryAttributeUpdatedSchema,
+		SchemaName: SchemaNameCategoryAttributeUpdated,
+		Topic:      TopicCatalogAttributeEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(CategoryAttributeUnassignedEvent{}),
+		SchemaJSON: CategoryAttributeUnassignedSchema,
+		SchemaName: SchemaNameCategoryAttributeUnassigned,
+		Topic:      TopicCatalogAttributeEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(CategoryAttributesBulkUpdatedEvent{}),
+		SchemaJSON: CategoryAttributesBulkUpdatedSchema,
+		SchemaName: SchemaNameCategoryAttributesBulkUpdated,
+		Topic:      TopicCatalogAttributeEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(CategoryCreatedEvent{}),
+		SchemaJSON: CategoryCreatedSchema,
+		SchemaName: SchemaNameCategoryCreated,
+		Topic:      TopicCatalogCategoryEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(CategoryUpdatedEvent{}),
+		SchemaJSON: CategoryUpdatedSchema,
+		SchemaName: SchemaNameCategoryUpdated,
+		Topic:      TopicCatalogCategoryEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(CategoryDeletedEvent{}),
+		SchemaJSON: CategoryDeletedSchema,
+		SchemaName: SchemaNameCategoryDeleted,
+		Topic:      TopicCatalogCategoryEvents,
+	},
+	{
+		GoType:     reflect.TypeOf(CategoryDisabledEvent{}),
+		SchemaJSON: CategoryDisabledSchema,
+		SchemaName: SchemaNameCategoryDisabled,
+		Topic:      TopicCatalogCategoryEvents,
+	},
+}
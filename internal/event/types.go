@@ -0,0 +1,290 @@
+package event
+
+import (
+	"time"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/events"
+)
+
+// AttributeOptionPayload is an attribute option carried in attribute events.
+type AttributeOptionPayload struct {
+	Name          string                       `avro:"name" json:"name"`
+	Slug          string                       `avro:"slug" json:"slug"`
+	ColorCode     *string                      `avro:"color_code" json:"color_code"`
+	Image         *AttributeOptionImagePayload `avro:"image" json:"image"`
+	SortOrder     int                          `avro:"sort_order" json:"sort_order"`
+	Enabled       bool                         `avro:"enabled" json:"enabled"`
+	Translations  map[string]string            `avro:"translations" json:"translations"` // locale -> option name
+	PreviousSlugs []string                     `avro:"previous_slugs" json:"previous_slugs"`
+}
+
+// AttributeOptionImagePayload is an image swatch of an option carried in attribute events.
+type AttributeOptionImagePayload struct {
+	URL string `avro:"url" json:"url"`
+	Alt string `avro:"alt" json:"alt"`
+}
+
+// AttributeTranslationPayload is a locale-specific attribute text carried in attribute events.
+type AttributeTranslationPayload struct {
+	Name string  `avro:"name" json:"name"`
+	Unit *string `avro:"unit" json:"unit"`
+}
+
+// AttributeRangePayload is a range configuration carried in attribute events.
+type AttributeRangePayload struct {
+	Min       float64  `avro:"min" json:"min"`
+	Max       float64  `avro:"max" json:"max"`
+	Step      *float64 `avro:"step" json:"step"`
+	Precision *int     `avro:"precision" json:"precision"`
+}
+
+// AttributeDatePayload holds the bounds of a date or datetime attribute carried in attribute events.
+type AttributeDatePayload struct {
+	Min *string `avro:"min" json:"min"`
+	Max *string `avro:"max" json:"max"`
+}
+
+// AttributeDimensionComponentPayload is a component of a dimension attribute carried in attribute events.
+type AttributeDimensionComponentPayload struct {
+	Key  string  `avro:"key" json:"key"`
+	Unit *string `avro:"unit" json:"unit"`
+	Min  float64 `avro:"min" json:"min"`
+	Max  float64 `avro:"max" json:"max"`
+}
+
+// AttributeDimensionPayload lists the components of a dimension attribute carried in attribute events.
+type AttributeDimensionPayload struct {
+	Components []AttributeDimensionComponentPayload `avro:"components" json:"components"`
+}
+
+// AttributeReferencePayload holds the target entity kind of a reference attribute carried in attribute events.
+type AttributeReferencePayload struct {
+	TargetKind string `avro:"target_kind" json:"target_kind"`
+}
+
+// AttributeTextPayload holds the constraints of a text attribute carried in attribute events.
+type AttributeTextPayload struct {
+	MinLength *int    `avro:"min_length" json:"min_length"`
+	MaxLength *int    `avro:"max_length" json:"max_length"`
+	Pattern   *string `avro:"pattern" json:"pattern"`
+	Multiline bool    `avro:"multiline" json:"multiline"`
+}
+
+// AttributeCreatedPayload is the business data of AttributeCreatedEvent.
+type AttributeCreatedPayload struct {
+	AttributeID   string                                 `avro:"attribute_id" json:"attribute_id"`
+	Name          string                                 `avro:"name" json:"name"`
+	Slug          string                                 `avro:"slug" json:"slug"`
+	PreviousSlugs []string                               `avro:"previous_slugs" json:"previous_slugs"`
+	Status        string                                 `avro:"status" json:"status"`
+	Type          string                                 `avro:"type" json:"type"`
+	Unit          *string                                `avro:"unit" json:"unit"`
+	Enabled       bool                                   `avro:"enabled" json:"enabled"`
+	Filterable    bool                                   `avro:"filterable" json:"filterable"`
+	Searchable    bool                                   `avro:"searchable" json:"searchable"`
+	Options       []AttributeOptionPayload               `avro:"options" json:"options"`
+	Range         *AttributeRangePayload                 `avro:"range" json:"range"`
+	Date          *AttributeDatePayload                  `avro:"date" json:"date"`
+	Dimension     *AttributeDimensionPayload             `avro:"dimension" json:"dimension"`
+	Reference     *AttributeReferencePayload             `avro:"reference" json:"reference"`
+	Text          *AttributeTextPayload                  `avro:"text" json:"text"`
+	SwatchMode    string                                 `avro:"swatch_mode" json:"swatch_mode"`
+	Translations  map[string]AttributeTranslationPayload `avro:"translations" json:"translations"`
+	Version       int                                    `avro:"version" json:"version"`
+	CreatedAt     time.Time                              `avro:"created_at" json:"created_at"`
+	ModifiedAt    time.Time                              `avro:"modified_at" json:"modified_at"`
+}
+
+// AttributeCreatedEvent is published when an attribute is created.
+type AttributeCreatedEvent struct {
+	Metadata events.EventMetadata    `avro:"metadata" json:"metadata"`
+	Payload  AttributeCreatedPayload `avro:"payload" json:"payload"`
+}
+
+// AttributeUpdatedPayload is the business data of AttributeUpdatedEvent.
+type AttributeUpdatedPayload struct {
+	AttributeID   string                                 `avro:"attribute_id" json:"attribute_id"`
+	Name          string                                 `avro:"name" json:"name"`
+	Slug          string                                 `avro:"slug" json:"slug"`
+	PreviousSlugs []string                               `avro:"previous_slugs" json:"previous_slugs"`
+	Status        string                                 `avro:"status" json:"status"`
+	Type          string                                 `avro:"type" json:"type"`
+	Unit          *string                                `avro:"unit" json:"unit"`
+	Enabled       bool                                   `avro:"enabled" json:"enabled"`
+	Filterable    bool                                   `avro:"filterable" json:"filterable"`
+	Searchable    bool                                   `avro:"searchable" json:"searchable"`
+	Options       []AttributeOptionPayload               `avro:"options" json:"options"`
+	Range         *AttributeRangePayload                 `avro:"range" json:"range"`
+	Date          *AttributeDatePayload                  `avro:"date" json:"date"`
+	Dimension     *AttributeDimensionPayload             `avro:"dimension" json:"dimension"`
+	Reference     *AttributeReferencePayload             `avro:"reference" json:"reference"`
+	Text          *AttributeTextPayload                  `avro:"text" json:"text"`
+	SwatchMode    string                                 `avro:"swatch_mode" json:"swatch_mode"`
+	Translations  map[string]AttributeTranslationPayload `avro:"translations" json:"translations"`
+	Version       int                                    `avro:"version" json:"version"`
+	ModifiedAt    time.Time                              `avro:"modified_at" json:"modified_at"`
+}
+
+// AttributeUpdatedEvent is published when an attribute is updated.
+type AttributeUpdatedEvent struct {
+	Metadata events.EventMetadata    `avro:"metadata" json:"metadata"`
+	Payload  AttributeUpdatedPayload `avro:"payload" json:"payload"`
+}
+
+// AttributeDeletedPayload is the business data of AttributeDeletedEvent.
+type AttributeDeletedPayload struct {
+	AttributeID string    `avro:"attribute_id" json:"attribute_id"`
+	Version     int       `avro:"version" json:"version"`
+	DeletedAt   time.Time `avro:"deleted_at" json:"deleted_at"`
+}
+
+// AttributeDeletedEvent is published when an attribute is deleted.
+type AttributeDeletedEvent struct {
+	Metadata events.EventMetadata    `avro:"metadata" json:"metadata"`
+	Payload  AttributeDeletedPayload `avro:"payload" json:"payload"`
+}
+
+// AttributeOptionRenamedPayload is the business data of AttributeOptionRenamedEvent.
+type AttributeOptionRenamedPayload struct {
+	AttributeID string    `avro:"attribute_id" json:"attribute_id"`
+	OldSlug     string    `avro:"old_slug" json:"old_slug"`
+	NewSlug     string    `avro:"new_slug" json:"new_slug"`
+	Version     int       `avro:"version" json:"version"`
+	ModifiedAt  time.Time `avro:"modified_at" json:"modified_at"`
+}
+
+// AttributeOptionRenamedEvent is published when the slug of an attribute option is renamed.
+type AttributeOptionRenamedEvent struct {
+	Metadata events.EventMetadata          `avro:"metadata" json:"metadata"`
+	Payload  AttributeOptionRenamedPayload `avro:"payload" json:"payload"`
+}
+
+// AttributeOptionsMergedPayload is the business data of AttributeOptionsMergedEvent.
+type AttributeOptionsMergedPayload struct {
+	AttributeID string    `avro:"attribute_id" json:"attribute_id"`
+	SourceSlug  string    `avro:"source_slug" json:"source_slug"`
+	TargetSlug  string    `avro:"target_slug" json:"target_slug"`
+	Version     int       `avro:"version" json:"version"`
+	ModifiedAt  time.Time `avro:"modified_at" json:"modified_at"`
+}
+
+// AttributeOptionsMergedEvent is published when an attribute option is merged into another one.
+type AttributeOptionsMergedEvent struct {
+	Metadata events.EventMetadata          `avro:"metadata" json:"metadata"`
+	Payload  AttributeOptionsMergedPayload `avro:"payload" json:"payload"`
+}
+
+// CategoryAttributeAssignedPayload is the business data of CategoryAttributeAssignedEvent.
+type CategoryAttributeAssignedPayload struct {
+	CategoryAttributeID string    `avro:"category_attribute_id" json:"category_attribute_id"`
+	CategoryID          string    `avro:"category_id" json:"category_id"`
+	AttributeID         string    `avro:"attribute_id" json:"attribute_id"`
+	Required            bool      `avro:"required" json:"required"`
+	SortOrder           int       `avro:"sort_order" json:"sort_order"`
+	Filterable          *bool     `avro:"filterable" json:"filterable"`
+	Searchable          *bool     `avro:"searchable" json:"searchable"`
+	Enabled             bool      `avro:"enabled" json:"enabled"`
+	CategoryDisabled    bool      `avro:"category_disabled" json:"category_disabled"`
+	GroupID             *string   `avro:"group_id" json:"group_id"`
+	GroupSortOrder      int       `avro:"group_sort_order" json:"group_sort_order"`
+	AllowedOptions      []string  `avro:"allowed_options" json:"allowed_options"`
+	DefaultValues       []string  `avro:"default_values" json:"default_values"`
+	Version             int       `avro:"version" json:"version"`
+	CreatedAt           time.Time `avro:"created_at" json:"created_at"`
+	ModifiedAt          time.Time `avro:"modified_at" json:"modified_at"`
+}
+
+// CategoryAttributeAssignedEvent is published when an attribute is assigned to a category.
+type CategoryAttributeAssignedEvent struct {
+	Metadata events.EventMetadata             `avro:"metadata" json:"metadata"`
+	Payload  CategoryAttributeAssignedPayload `avro:"payload" json:"payload"`
+}
+
+// CategoryAttributeUpdatedPayload is the business data of CategoryAttributeUpdatedEvent.
+type CategoryAttributeUpdatedPayload struct {
+	CategoryAttributeID string    `avro:"category_attribute_id" json:"category_attribute_id"`
+	CategoryID          string    `avro:"category_id" json:"category_id"`
+	AttributeID         string    `avro:"attribute_id" json:"attribute_id"`
+	Required            bool      `avro:"required" json:"required"`
+	SortOrder           int       `avro:"sort_order" json:"sort_order"`
+	Filterable          *bool     `avro:"filterable" json:"filterable"`
+	Searchable          *bool     `avro:"searchable" json:"searchable"`
+	Enabled             bool      `avro:"enabled" json:"enabled"`
+	CategoryDisabled    bool      `avro:"category_disabled" json:"category_disabled"`
+	GroupID             *string   `avro:"group_id" json:"group_id"`
+	GroupSortOrder      int       `avro:"group_sort_order" json:"group_sort_order"`
+	AllowedOptions      []string  `avro:"allowed_options" json:"allowed_options"`
+	DefaultValues       []string  `avro:"default_values" json:"default_values"`
+	Version             int       `avro:"version" json:"version"`
+	ModifiedAt          time.Time `avro:"modified_at" json:"modified_at"`
+}
+
+// CategoryAttributeUpdatedEvent is published when a category assignment is updated.
+type CategoryAttributeUpdatedEvent struct {
+	Metadata events.EventMetadata            `avro:"metadata" json:"metadata"`
+	Payload  CategoryAttributeUpdatedPayload `avro:"payload" json:"payload"`
+}
+
+// CategoryAttributeUnassignedPayload is the business data of CategoryAttributeUnassignedEvent.
+type CategoryAttributeUnassignedPayload struct {
+	CategoryAttributeID string `avro:"category_attribute_id" json:"category_attribute_id"`
+	CategoryID          string `avro:"category_id" json:"category_id"`
+	AttributeID         string `avro:"attribute_id" json:"attribute_id"`
+}
+
+// CategoryAttributeUnassignedEvent is published when an attribute is removed from a category.
+type CategoryAttributeUnassignedEvent struct {
+	Metadata events.EventMetadata               `avro:"metadata" json:"metadata"`
+	Payload  CategoryAttributeUnassignedPayload `avro:"payload" json:"payload"`
+}
+
+// CategoryAttributeSnapshot is the state of a category assignment after a bulk write.
+type CategoryAttributeSnapshot struct {
+	CategoryAttributeID string    `avro:"category_attribute_id" json:"category_attribute_id"`
+	CategoryID          string    `avro:"category_id" json:"category_id"`
+	AttributeID         string    `avro:"attribute_id" json:"attribute_id"`
+	Required            bool      `avro:"required" json:"required"`
+	SortOrder           int       `avro:"sort_order" json:"sort_order"`
+	Filterable          *bool     `avro:"filterable" json:"filterable"`
+	Searchable          *bool     `avro:"searchable" json:"searchable"`
+	Enabled             bool      `avro:"enabled" json:"enabled"`
+	CategoryDisabled    bool      `avro:"category_disabled" json:"category_disabled"`
+	GroupID             *string   `avro:"group_id" json:"group_id"`
+	GroupSortOrder      int       `avro:"group_sort_order" json:"group_sort_order"`
+	AllowedOptions      []string  `avro:"allowed_options" json:"allowed_options"`
+	DefaultValues       []string  `avro:"default_values" json:"default_values"`
+	Version             int       `avro:"version" json:"version"`
+	CreatedAt           time.Time `avro:"created_at" json:"created_at"`
+	ModifiedAt          time.Time `avro:"modified_at" json:"modified_at"`
+}
+
+// UnassignedCategoryAttribute is an assignment removed by a bulk write.
+type UnassignedCategoryAttribute struct {
+	CategoryAttributeID string `avro:"category_attribute_id" json:"category_attribute_id"`
+	AttributeID         string `avro:"attribute_id" json:"attribute_id"`
+}
+
+// CategoryAttributesBulkUpdatedPayload is the business data of CategoryAttributesBulkUpdatedEvent.
+type CategoryAttributesBulkUpdatedPayload struct {
+	CategoryID  string                        `avro:"category_id" json:"category_id"`
+	Mode        string                        `avro:"mode" json:"mode"`
+	Assignments []CategoryAttributeSnapshot   `avro:"assignments" json:"assignments"`
+	Unassigned  []UnassignedCategoryAttribute `avro:"unassigned" json:"unassigned"`
+	ModifiedAt  time.Time                     `avro:"modified_at" json:"modified_at"`
+}
+
+// CategoryAttributesBulkUpdatedEvent is published once per bulk write of a category attribute list.
+type CategoryAttributesBulkUpdatedEvent struct {
+	Metadata events.EventMetadata                 `avro:"metadata" json:"metadata"`
+	Payload  CategoryAttributesBulkUpdatedPayload `avro:"payload" json:"payload"`
+}
+
+func (e *AttributeCreatedEvent) GetMetadata() *events.EventMetadata              { return &e.Metadata }
+func (e *AttributeUpdatedEvent) GetMetadata() *events.EventMetadata              { return &e.Metadata }
+func (e *AttributeDeletedEvent) GetMetadata() *events.EventMetadata              { return &e.Metadata }
+func (e *AttributeOptionRenamedEvent) GetMetadata() *events.EventMetadata        { return &e.Metadata }
+func (e *AttributeOptionsMergedEvent) GetMetadata() *events.EventMetadata        { return &e.Metadata }
+func (e *CategoryAttributeAssignedEvent) GetMetadata() *events.EventMetadata     { return &e.Metadata }
+func (e *CategoryAttributeUpdatedEvent) GetMetadata() *events.EventMetadata      { return &e.Metadata }
+func (e *CategoryAttributeUnassignedEvent) GetMetadata() *events.EventMetadata   { return &e.Metadata }
+func (e *CategoryAttributesBulkUpdatedEvent) GetMetadata() *events.EventMetadata { return &e.Metadata }
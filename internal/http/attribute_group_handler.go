@@ -0,0 +1,184 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service-api/gen/httpapi"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/application/command"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/application/query"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+type attributeGroupHandler struct {
+	createHandler  command.CreateAttributeGroupCommandHandler
+	updateHandler  command.UpdateAttributeGroupCommandHandler
+	deleteHandler  command.DeleteAttributeGroupCommandHandler
+	getByIDHandler query.GetAttributeGroupByIDQueryHandler
+	getListHandler query.GetAttributeGroupListQueryHandler
+}
+
+func newAttributeGroupHandler(
+	createHandler command.CreateAttributeGroupCommandHandler,
+	updateHandler command.UpdateAttributeGroupCommandHandler,
+	deleteHandler command.DeleteAttributeGroupCommandHandler,
+	getByIDHandler query.GetAttributeGroupByIDQueryHandler,
+	getListHandler query.GetAttributeGroupListQueryHandler,
+) *attributeGroupHandler {
+	return &attributeGroupHandler{
+		createHandler:  createHandler,
+		updateHandler:  updateHandler,
+		deleteHandler:  deleteHandler,
+		getByIDHandler: getByIDHandler,
+		getListHandler: getListHandler,
+	}
+}
+
+func toAttributeGroupResponse(g *attributegroup.AttributeGroup) *httpapi.AttributeGroupResponse {
+	return &httpapi.AttributeGroupResponse{
+		ID:         g.ID,
+		Version:    g.Version,
+		Name:       g.Name,
+		Slug:       g.Slug,
+		SortOrder:  g.SortOrder,
+		CreatedAt:  g.CreatedAt,
+		ModifiedAt: g.ModifiedAt,
+	}
+}
+
+func (h *attributeGroupHandler) CreateAttributeGroup(ctx context.Context, req *httpapi.CreateAttributeGroupReq) (httpapi.CreateAttributeGroupRes, error) {
+	cmd := command.CreateAttributeGroupCommand{
+		ID:        lo.If(req.ID.IsSet(), &req.ID.Value).Else(nil),
+		Name:      req.Name,
+		Slug:      req.Slug,
+		SortOrder: req.SortOrder.Or(0),
+	}
+
+	created, err := h.createHandler.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, attributegroup.ErrSlugAlreadyExists) {
+			return &httpapi.CreateAttributeGroupConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute group with this slug already exists",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return toAttributeGroupResponse(created), nil
+}
+
+func (h *attributeGroupHandler) GetAttributeGroupById(ctx context.Context, params httpapi.GetAttributeGroupByIdParams) (httpapi.GetAttributeGroupByIdRes, error) {
+	found, err := h.getByIDHandler.Handle(ctx, query.GetAttributeGroupByIDQuery{ID: params.ID})
+	if errors.Is(err, persistence.ErrEntityNotFound) {
+		return &httpapi.GetAttributeGroupByIdNotFound{
+			Status: 404,
+			Type:   *aboutBlankURL,
+			Title:  "Attribute group not found",
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toAttributeGroupResponse(found), nil
+}
+
+func (h *attributeGroupHandler) GetAttributeGroupList(ctx context.Context, params httpapi.GetAttributeGroupListParams) (httpapi.GetAttributeGroupListRes, error) {
+	q := query.GetAttributeGroupListQuery{
+		Page:  params.Page,
+		Size:  params.Size,
+		Sort:  string(params.Sort.Or(httpapi.GetAttributeGroupListSortSortOrder)),
+		Order: string(params.Order.Or(httpapi.GetAttributeGroupListOrderAsc)),
+	}
+
+	result, err := h.getListHandler.Handle(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpapi.AttributeGroupListResponse{
+		Items: lo.Map(result.Items, func(g *attributegroup.AttributeGroup, _ int) httpapi.AttributeGroupResponse {
+			return *toAttributeGroupResponse(g)
+		}),
+		Page:  result.Page,
+		Size:  result.Size,
+		Total: int(result.Total),
+	}, nil
+}
+
+func (h *attributeGroupHandler) UpdateAttributeGroup(ctx context.Context, req *httpapi.UpdateAttributeGroupReq) (httpapi.UpdateAttributeGroupRes, error) {
+	cmd := command.UpdateAttributeGroupCommand{
+		ID:        req.ID.String(),
+		Version:   req.Version,
+		Name:      req.Name,
+		Slug:      req.Slug,
+		SortOrder: req.SortOrder.Or(0),
+	}
+
+	updated, err := h.updateHandler.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.UpdateAttributeGroupNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute group not found",
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.UpdateAttributeGroupPreconditionFailed{
+				Status: 412,
+				Type:   *aboutBlankURL,
+				Title:  "Version mismatch",
+			}, nil
+		}
+		if errors.Is(err, attributegroup.ErrSlugAlreadyExists) {
+			return &httpapi.UpdateAttributeGroupConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute group with this slug already exists",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return toAttributeGroupResponse(updated), nil
+}
+
+func (h *attributeGroupHandler) DeleteAttributeGroup(ctx context.Context, params httpapi.DeleteAttributeGroupParams) (httpapi.DeleteAttributeGroupRes, error) {
+	cmd := command.DeleteAttributeGroupCommand{
+		ID:      params.ID,
+		Version: params.Version,
+	}
+
+	if err := h.deleteHandler.Handle(ctx, cmd); err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.DeleteAttributeGroupNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute group not found",
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.DeleteAttributeGroupPreconditionFailed{
+				Status: 412,
+				Type:   *aboutBlankURL,
+				Title:  "Version mismatch",
+			}, nil
+		}
+		if errors.Is(err, attributegroup.ErrGroupInUse) {
+			return &httpapi.DeleteAttributeGroupConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute group is used by category attributes",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &httpapi.DeleteAttributeGroupNoContent{}, nil
+}
@@ -11,27 +11,43 @@ import (
 	"github.com/Sokol111/ecommerce-attribute-service/internal/application/command"
 	"github.com/Sokol111/ecommerce-attribute-service/internal/application/query"
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
 )
 
 type attributeHandler struct {
-	createHandler  command.CreateAttributeCommandHandler
-	updateHandler  command.UpdateAttributeCommandHandler
-	getByIDHandler query.GetAttributeByIDQueryHandler
-	getListHandler query.GetAttributeListQueryHandler
+	createHandler       command.CreateAttributeCommandHandler
+	updateHandler       command.UpdateAttributeCommandHandler
+	deleteHandler       command.DeleteAttributeCommandHandler
+	renameOptionHandler command.RenameAttributeOptionCommandHandler
+	mergeOptionsHandler command.MergeAttributeOptionsCommandHandler
+	statusHandler       command.ChangeAttributeStatusCommandHandler
+	getByIDHandler      query.GetAttributeByIDQueryHandler
+	getBySlugHandler    query.GetAttributeBySlugQueryHandler
+	getListHandler      query.GetAttributeListQueryHandler
 }
 
 func newAttributeHandler(
 	createHandler command.CreateAttributeCommandHandler,
 	updateHandler command.UpdateAttributeCommandHandler,
+	deleteHandler command.DeleteAttributeCommandHandler,
+	renameOptionHandler command.RenameAttributeOptionCommandHandler,
+	mergeOptionsHandler command.MergeAttributeOptionsCommandHandler,
+	statusHandler command.ChangeAttributeStatusCommandHandler,
 	getByIDHandler query.GetAttributeByIDQueryHandler,
+	getBySlugHandler query.GetAttributeBySlugQueryHandler,
 	getListHandler query.GetAttributeListQueryHandler,
-) httpapi.Handler {
+) *attributeHandler {
 	return &attributeHandler{
-		createHandler:  createHandler,
-		updateHandler:  updateHandler,
-		getByIDHandler: getByIDHandler,
-		getListHandler: getListHandler,
+		createHandler:       createHandler,
+		updateHandler:       updateHandler,
+		deleteHandler:       deleteHandler,
+		renameOptionHandler: renameOptionHandler,
+		mergeOptionsHandler: mergeOptionsHandler,
+		statusHandler:       statusHandler,
+		getByIDHandler:      getByIDHandler,
+		getBySlugHandler:    getBySlugHandler,
+		getListHandler:      getListHandler,
 	}
 }
 
@@ -44,50 +60,245 @@ func toOptString(s *string) httpapi.OptString {
 	return httpapi.NewOptString(*s)
 }
 
+func toOptFloat64(f *float64) httpapi.OptFloat64 {
+	if f == nil {
+		return httpapi.OptFloat64{}
+	}
+	return httpapi.NewOptFloat64(*f)
+}
+
+func toOptInt(i *int) httpapi.OptInt {
+	if i == nil {
+		return httpapi.OptInt{}
+	}
+	return httpapi.NewOptInt(*i)
+}
+
 func toAttributeOptionResponse(opt attribute.Option, _ int) httpapi.AttributeOption {
 	return httpapi.AttributeOption{
-		Name:      opt.Name,
-		Slug:      opt.Slug,
-		ColorCode: toOptString(opt.ColorCode),
-		SortOrder: opt.SortOrder,
-		Enabled:   opt.Enabled,
+		Name:          opt.Name,
+		Slug:          opt.Slug,
+		ColorCode:     toOptString(opt.ColorCode),
+		Image:         toOptOptionImage(opt.Image),
+		SortOrder:     opt.SortOrder,
+		Enabled:       opt.Enabled,
+		Translations:  httpapi.OptionTranslations(opt.Translations),
+		PreviousSlugs: opt.PreviousSlugs,
+	}
+}
+
+func toOptOptionImage(image *attribute.OptionImage) httpapi.OptOptionImage {
+	if image == nil {
+		return httpapi.OptOptionImage{}
+	}
+	return httpapi.NewOptOptionImage(httpapi.OptionImage{
+		URL: image.URL,
+		Alt: image.Alt,
+	})
+}
+
+func toAttributeTranslationsResponse(translations map[string]attribute.Translation) httpapi.AttributeTranslations {
+	return lo.MapValues(translations, func(t attribute.Translation, _ string) httpapi.AttributeTranslation {
+		return httpapi.AttributeTranslation{
+			Name: t.Name,
+			Unit: toOptString(t.Unit),
+		}
+	})
+}
+
+func toOptRangeConfig(cfg *attribute.RangeConfig) httpapi.OptRangeConfig {
+	if cfg == nil {
+		return httpapi.OptRangeConfig{}
+	}
+	return httpapi.NewOptRangeConfig(httpapi.RangeConfig{
+		Min:       cfg.Min,
+		Max:       cfg.Max,
+		Step:      toOptFloat64(cfg.Step),
+		Precision: toOptInt(cfg.Precision),
+	})
+}
+
+func toOptDateConfig(cfg *attribute.DateConfig) httpapi.OptDateConfig {
+	if cfg == nil {
+		return httpapi.OptDateConfig{}
+	}
+	return httpapi.NewOptDateConfig(httpapi.DateConfig{
+		Min: toOptString(cfg.Min),
+		Max: toOptString(cfg.Max),
+	})
+}
+
+func toOptDimensionConfig(cfg *attribute.DimensionConfig) httpapi.OptDimensionConfig {
+	if cfg == nil {
+		return httpapi.OptDimensionConfig{}
+	}
+	return httpapi.NewOptDimensionConfig(httpapi.DimensionConfig{
+		Components: lo.Map(cfg.Components, func(c attribute.DimensionComponent, _ int) httpapi.DimensionComponent {
+			return httpapi.DimensionComponent{
+				Key:  c.Key,
+				Unit: toOptString(c.Unit),
+				Min:  c.Min,
+				Max:  c.Max,
+			}
+		}),
+	})
+}
+
+func toOptReferenceConfig(cfg *attribute.ReferenceConfig) httpapi.OptReferenceConfig {
+	if cfg == nil {
+		return httpapi.OptReferenceConfig{}
 	}
+	return httpapi.NewOptReferenceConfig(httpapi.ReferenceConfig{TargetKind: cfg.TargetKind})
+}
+
+func toOptTextConfig(cfg *attribute.TextConfig) httpapi.OptTextConfig {
+	if cfg == nil {
+		return httpapi.OptTextConfig{}
+	}
+	return httpapi.NewOptTextConfig(httpapi.TextConfig{
+		MinLength: toOptInt(cfg.MinLength),
+		MaxLength: toOptInt(cfg.MaxLength),
+		Pattern:   toOptString(cfg.Pattern),
+		Multiline: httpapi.NewOptBool(cfg.Multiline),
+	})
 }
 
 func toAttributeResponse(a *attribute.Attribute) *httpapi.AttributeResponse {
 	return &httpapi.AttributeResponse{
-		ID:         a.ID,
-		Version:    a.Version,
-		Name:       a.Name,
-		Slug:       a.Slug,
-		Type:       httpapi.AttributeResponseType(a.Type),
-		Unit:       toOptString(a.Unit),
-		Enabled:    a.Enabled,
-		Options:    lo.Map(a.Options, toAttributeOptionResponse),
-		CreatedAt:  a.CreatedAt,
-		ModifiedAt: a.ModifiedAt,
+		ID:            a.ID,
+		Version:       a.Version,
+		Name:          a.Name,
+		Slug:          a.Slug,
+		PreviousSlugs: a.PreviousSlugs,
+		Status:        httpapi.AttributeResponseStatus(a.Status),
+		Type:          httpapi.AttributeResponseType(a.Type),
+		Unit:          toOptString(a.Unit),
+		Enabled:       a.Enabled,
+		Filterable:    a.Filterable,
+		Searchable:    a.Searchable,
+		Options:       lo.Map(a.Options, toAttributeOptionResponse),
+		Range:         toOptRangeConfig(a.TypeConfig.Range),
+		Date:          toOptDateConfig(a.TypeConfig.Date),
+		Dimension:     toOptDimensionConfig(a.TypeConfig.Dimension),
+		Reference:     toOptReferenceConfig(a.TypeConfig.Reference),
+		Text:          toOptTextConfig(a.TypeConfig.Text),
+		SwatchMode:    httpapi.AttributeResponseSwatchMode(a.TypeConfig.SwatchMode),
+		Translations:  toAttributeTranslationsResponse(a.Translations),
+		CreatedAt:     a.CreatedAt,
+		ModifiedAt:    a.ModifiedAt,
 	}
 }
 
 func toOptionInput(opt httpapi.AttributeOptionInput, _ int) command.OptionInput {
 	return command.OptionInput{
-		Name:      opt.Name,
-		Slug:      opt.Slug,
-		ColorCode: lo.If(opt.ColorCode.IsSet(), &opt.ColorCode.Value).Else(nil),
-		SortOrder: opt.SortOrder.Or(0),
-		Enabled:   opt.Enabled,
+		Name:         opt.Name,
+		Slug:         opt.Slug,
+		ColorCode:    lo.If(opt.ColorCode.IsSet(), &opt.ColorCode.Value).Else(nil),
+		Image:        toOptionImageInput(opt.Image),
+		SortOrder:    opt.SortOrder.Or(0),
+		Enabled:      opt.Enabled,
+		Translations: opt.Translations.Or(nil),
+	}
+}
+
+func toOptionImageInput(opt httpapi.OptOptionImage) *command.OptionImageInput {
+	if !opt.IsSet() {
+		return nil
+	}
+	return &command.OptionImageInput{
+		URL: opt.Value.URL,
+		Alt: opt.Value.Alt,
+	}
+}
+
+func toTranslationInputs(opt httpapi.OptAttributeTranslations) map[string]command.TranslationInput {
+	if !opt.IsSet() {
+		return nil
+	}
+	return lo.MapValues(opt.Value, func(t httpapi.AttributeTranslation, _ string) command.TranslationInput {
+		return command.TranslationInput{
+			Name: t.Name,
+			Unit: lo.If(t.Unit.IsSet(), &t.Unit.Value).Else(nil),
+		}
+	})
+}
+
+func toRangeInput(opt httpapi.OptRangeConfig) *command.RangeInput {
+	if !opt.IsSet() {
+		return nil
+	}
+	return &command.RangeInput{
+		Min:       opt.Value.Min,
+		Max:       opt.Value.Max,
+		Step:      lo.If(opt.Value.Step.IsSet(), &opt.Value.Step.Value).Else(nil),
+		Precision: lo.If(opt.Value.Precision.IsSet(), &opt.Value.Precision.Value).Else(nil),
+	}
+}
+
+func toDateInput(opt httpapi.OptDateConfig) *command.DateInput {
+	if !opt.IsSet() {
+		return nil
+	}
+	return &command.DateInput{
+		Min: lo.If(opt.Value.Min.IsSet(), &opt.Value.Min.Value).Else(nil),
+		Max: lo.If(opt.Value.Max.IsSet(), &opt.Value.Max.Value).Else(nil),
+	}
+}
+
+func toDimensionInput(opt httpapi.OptDimensionConfig) *command.DimensionInput {
+	if !opt.IsSet() {
+		return nil
+	}
+	return &command.DimensionInput{
+		Components: lo.Map(opt.Value.Components, func(c httpapi.DimensionComponent, _ int) command.DimensionComponentInput {
+			return command.DimensionComponentInput{
+				Key:  c.Key,
+				Unit: lo.If(c.Unit.IsSet(), &c.Unit.Value).Else(nil),
+				Min:  c.Min,
+				Max:  c.Max,
+			}
+		}),
+	}
+}
+
+func toReferenceInput(opt httpapi.OptReferenceConfig) *command.ReferenceInput {
+	if !opt.IsSet() {
+		return nil
+	}
+	return &command.ReferenceInput{TargetKind: opt.Value.TargetKind}
+}
+
+func toTextInput(opt httpapi.OptTextConfig) *command.TextInput {
+	if !opt.IsSet() {
+		return nil
+	}
+	return &command.TextInput{
+		MinLength: lo.If(opt.Value.MinLength.IsSet(), &opt.Value.MinLength.Value).Else(nil),
+		MaxLength: lo.If(opt.Value.MaxLength.IsSet(), &opt.Value.MaxLength.Value).Else(nil),
+		Pattern:   lo.If(opt.Value.Pattern.IsSet(), &opt.Value.Pattern.Value).Else(nil),
+		Multiline: opt.Value.Multiline.Or(false),
 	}
 }
 
 func (h *attributeHandler) CreateAttribute(ctx context.Context, req *httpapi.CreateAttributeReq) (httpapi.CreateAttributeRes, error) {
 	cmd := command.CreateAttributeCommand{
-		ID:      lo.If(req.ID.IsSet(), &req.ID.Value).Else(nil),
-		Name:    req.Name,
-		Slug:    req.Slug,
-		Type:    string(req.Type),
-		Unit:    lo.If(req.Unit.IsSet(), &req.Unit.Value).Else(nil),
-		Enabled: req.Enabled,
-		Options: lo.Map(req.Options, toOptionInput),
+		ID:           lo.If(req.ID.IsSet(), &req.ID.Value).Else(nil),
+		Name:         req.Name,
+		Slug:         req.Slug,
+		Status:       string(req.Status.Or(httpapi.CreateAttributeReqStatusActive)),
+		Type:         string(req.Type),
+		Unit:         lo.If(req.Unit.IsSet(), &req.Unit.Value).Else(nil),
+		Enabled:      req.Enabled,
+		Filterable:   req.Filterable.Or(false),
+		Searchable:   req.Searchable.Or(false),
+		Options:      lo.Map(req.Options, toOptionInput),
+		Range:        toRangeInput(req.Range),
+		Date:         toDateInput(req.Date),
+		Dimension:    toDimensionInput(req.Dimension),
+		Reference:    toReferenceInput(req.Reference),
+		Text:         toTextInput(req.Text),
+		SwatchMode:   string(req.SwatchMode.Or(httpapi.CreateAttributeReqSwatchModeText)),
+		Translations: toTranslationInputs(req.Translations),
 	}
 
 	created, err := h.createHandler.Handle(ctx, cmd)
@@ -106,7 +317,10 @@ func (h *attributeHandler) CreateAttribute(ctx context.Context, req *httpapi.Cre
 }
 
 func (h *attributeHandler) GetAttributeById(ctx context.Context, params httpapi.GetAttributeByIdParams) (httpapi.GetAttributeByIdRes, error) {
-	q := query.GetAttributeByIDQuery{ID: params.ID}
+	q := query.GetAttributeByIDQuery{
+		ID:             params.ID,
+		AcceptLanguage: params.AcceptLanguage.Or(""),
+	}
 
 	found, err := h.getByIDHandler.Handle(ctx, q)
 	if errors.Is(err, persistence.ErrEntityNotFound) {
@@ -123,6 +337,30 @@ func (h *attributeHandler) GetAttributeById(ctx context.Context, params httpapi.
 	return toAttributeResponse(found), nil
 }
 
+func (h *attributeHandler) GetAttributeBySlug(ctx context.Context, params httpapi.GetAttributeBySlugParams) (httpapi.GetAttributeBySlugRes, error) {
+	q := query.GetAttributeBySlugQuery{
+		Slug:           params.Slug,
+		AcceptLanguage: params.AcceptLanguage.Or(""),
+	}
+
+	result, err := h.getBySlugHandler.Handle(ctx, q)
+	if errors.Is(err, persistence.ErrEntityNotFound) {
+		return &httpapi.GetAttributeBySlugNotFound{
+			Status: 404,
+			Type:   *aboutBlankURL,
+			Title:  "Attribute not found",
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpapi.AttributeBySlugResponse{
+		Attribute:  *toAttributeResponse(result.Attribute),
+		Redirected: result.Redirected,
+	}, nil
+}
+
 func (h *attributeHandler) GetAttributeList(ctx context.Context, params httpapi.GetAttributeListParams) (httpapi.GetAttributeListRes, error) {
 	var attrType *string
 	if params.Type.IsSet() {
@@ -135,13 +373,21 @@ func (h *attributeHandler) GetAttributeList(ctx context.Context, params httpapi.
 		enabled = &params.Enabled.Value
 	}
 
+	var status *string
+	if params.Status.IsSet() {
+		s := string(params.Status.Value)
+		status = &s
+	}
+
 	q := query.GetAttributeListQuery{
-		Page:    params.Page,
-		Size:    params.Size,
-		Enabled: enabled,
-		Type:    attrType,
-		Sort:    string(params.Sort.Or(httpapi.GetAttributeListSortName)),
-		Order:   string(params.Order.Or(httpapi.GetAttributeListOrderAsc)),
+		Page:           params.Page,
+		Size:           params.Size,
+		Enabled:        enabled,
+		Type:           attrType,
+		Status:         status,
+		Sort:           string(params.Sort.Or(httpapi.GetAttributeListSortName)),
+		Order:          string(params.Order.Or(httpapi.GetAttributeListOrderAsc)),
+		AcceptLanguage: params.AcceptLanguage.Or(""),
 	}
 
 	result, err := h.getListHandler.Handle(ctx, q)
@@ -161,14 +407,23 @@ func (h *attributeHandler) GetAttributeList(ctx context.Context, params httpapi.
 
 func (h *attributeHandler) UpdateAttribute(ctx context.Context, req *httpapi.UpdateAttributeReq) (httpapi.UpdateAttributeRes, error) {
 	cmd := command.UpdateAttributeCommand{
-		ID:      req.ID.String(),
-		Version: req.Version,
-		Name:    req.Name,
-		Slug:    req.Slug,
-		Type:    string(req.Type),
-		Unit:    lo.If(req.Unit.IsSet(), &req.Unit.Value).Else(nil),
-		Enabled: req.Enabled,
-		Options: lo.Map(req.Options, toOptionInput),
+		ID:           req.ID.String(),
+		Version:      req.Version,
+		Name:         req.Name,
+		Slug:         req.Slug,
+		Type:         string(req.Type),
+		Unit:         lo.If(req.Unit.IsSet(), &req.Unit.Value).Else(nil),
+		Enabled:      req.Enabled,
+		Filterable:   lo.If(req.Filterable.IsSet(), &req.Filterable.Value).Else(nil),
+		Searchable:   lo.If(req.Searchable.IsSet(), &req.Searchable.Value).Else(nil),
+		Options:      lo.Map(req.Options, toOptionInput),
+		Range:        toRangeInput(req.Range),
+		Date:         toDateInput(req.Date),
+		Dimension:    toDimensionInput(req.Dimension),
+		Reference:    toReferenceInput(req.Reference),
+		Text:         toTextInput(req.Text),
+		SwatchMode:   string(req.SwatchMode.Or(httpapi.UpdateAttributeReqSwatchModeText)),
+		Translations: toTranslationInputs(req.Translations),
 	}
 
 	updated, err := h.updateHandler.Handle(ctx, cmd)
@@ -194,6 +449,166 @@ func (h *attributeHandler) UpdateAttribute(ctx context.Context, req *httpapi.Upd
 				Title:  "Attribute with this slug already exists",
 			}, nil
 		}
+		if errors.Is(err, attribute.ErrIncompatibleTypeChange) {
+			return &httpapi.UpdateAttributeConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute type cannot be changed while assigned to categories",
+			}, nil
+		}
+		if errors.Is(err, attribute.ErrOptionSlugTaken) {
+			return &httpapi.UpdateAttributeConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Option slug is still used by a renamed or merged option",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, categoryattribute.ErrNoAllowedOption) {
+			return &httpapi.UpdateAttributeConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Removed options are the only allowed options of a category",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return toAttributeResponse(updated), nil
+}
+
+func (h *attributeHandler) ChangeAttributeStatus(ctx context.Context, req *httpapi.ChangeAttributeStatusReq, params httpapi.ChangeAttributeStatusParams) (httpapi.ChangeAttributeStatusRes, error) {
+	cmd := command.ChangeAttributeStatusCommand{
+		ID:      params.ID,
+		Version: req.Version,
+		Status:  string(req.Status),
+	}
+
+	updated, err := h.statusHandler.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.ChangeAttributeStatusNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute not found",
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.ChangeAttributeStatusPreconditionFailed{
+				Status: 412,
+				Type:   *aboutBlankURL,
+				Title:  "Version mismatch",
+			}, nil
+		}
+		if errors.Is(err, attribute.ErrInvalidStatusTransition) {
+			return &httpapi.ChangeAttributeStatusConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute status transition is not allowed",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return toAttributeResponse(updated), nil
+}
+
+func (h *attributeHandler) RenameAttributeOption(ctx context.Context, req *httpapi.RenameAttributeOptionReq, params httpapi.RenameAttributeOptionParams) (httpapi.RenameAttributeOptionRes, error) {
+	cmd := command.RenameAttributeOptionCommand{
+		AttributeID: params.ID,
+		Version:     req.Version,
+		Slug:        params.Slug,
+		NewSlug:     req.NewSlug,
+	}
+
+	updated, err := h.renameOptionHandler.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.RenameAttributeOptionNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute not found",
+			}, nil
+		}
+		if errors.Is(err, attribute.ErrUnknownOption) {
+			return &httpapi.RenameAttributeOptionNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute option not found",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.RenameAttributeOptionPreconditionFailed{
+				Status: 412,
+				Type:   *aboutBlankURL,
+				Title:  "Version mismatch",
+			}, nil
+		}
+		if errors.Is(err, attribute.ErrOptionSlugTaken) {
+			return &httpapi.RenameAttributeOptionConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Option slug is already used by the attribute",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, attribute.ErrInvalidAttributeData) {
+			return &httpapi.RenameAttributeOptionBadRequest{
+				Status: 400,
+				Type:   *aboutBlankURL,
+				Title:  "Invalid option slug",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return toAttributeResponse(updated), nil
+}
+
+func (h *attributeHandler) MergeAttributeOptions(ctx context.Context, req *httpapi.MergeAttributeOptionsReq, params httpapi.MergeAttributeOptionsParams) (httpapi.MergeAttributeOptionsRes, error) {
+	cmd := command.MergeAttributeOptionsCommand{
+		AttributeID: params.ID,
+		Version:     req.Version,
+		SourceSlug:  req.SourceSlug,
+		TargetSlug:  req.TargetSlug,
+	}
+
+	updated, err := h.mergeOptionsHandler.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.MergeAttributeOptionsNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute not found",
+			}, nil
+		}
+		if errors.Is(err, attribute.ErrUnknownOption) {
+			return &httpapi.MergeAttributeOptionsNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute option not found",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.MergeAttributeOptionsPreconditionFailed{
+				Status: 412,
+				Type:   *aboutBlankURL,
+				Title:  "Version mismatch",
+			}, nil
+		}
+		if errors.Is(err, attribute.ErrInvalidAttributeData) {
+			return &httpapi.MergeAttributeOptionsBadRequest{
+				Status: 400,
+				Type:   *aboutBlankURL,
+				Title:  "Options cannot be merged",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
 		return nil, err
 	}
 
@@ -201,9 +616,36 @@ func (h *attributeHandler) UpdateAttribute(ctx context.Context, req *httpapi.Upd
 }
 
 func (h *attributeHandler) DeleteAttribute(ctx context.Context, params httpapi.DeleteAttributeParams) (httpapi.DeleteAttributeRes, error) {
-	return &httpapi.DeleteAttributeInternalServerError{
-		Status: 500,
-		Type:   *aboutBlankURL,
-		Title:  "Not implemented",
-	}, nil
+	cmd := command.DeleteAttributeCommand{
+		ID:      params.ID,
+		Version: params.Version,
+		Cascade: params.Cascade.Or(false),
+	}
+
+	if err := h.deleteHandler.Handle(ctx, cmd); err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.DeleteAttributeNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute not found",
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.DeleteAttributePreconditionFailed{
+				Status: 412,
+				Type:   *aboutBlankURL,
+				Title:  "Version mismatch",
+			}, nil
+		}
+		if errors.Is(err, attribute.ErrAttributeInUse) {
+			return &httpapi.DeleteAttributeConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute is assigned to categories",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &httpapi.DeleteAttributeNoContent{}, nil
 }
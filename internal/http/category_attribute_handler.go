@@ -0,0 +1,504 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service-api/gen/httpapi"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/application/command"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/application/query"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+type categoryAttributeHandler struct {
+	assignHandler    command.AssignAttributeToCategoryCommandHandler
+	updateHandler    command.UpdateCategoryAttributeCommandHandler
+	unassignHandler  command.UnassignAttributeFromCategoryCommandHandler
+	setHandler       command.SetCategoryAttributesCommandHandler
+	copyHandler      command.CopyCategoryAttributesCommandHandler
+	getListHandler   query.GetCategoryAttributeListQueryHandler
+	getSchemaHandler query.GetCategorySchemaQueryHandler
+	getAttrHandler   query.GetAttributeByIDQueryHandler
+	validateHandler  query.ValidateProductAttributesQueryHandler
+}
+
+func newCategoryAttributeHandler(
+	assignHandler command.AssignAttributeToCategoryCommandHandler,
+	updateHandler command.UpdateCategoryAttributeCommandHandler,
+	unassignHandler command.UnassignAttributeFromCategoryCommandHandler,
+	setHandler command.SetCategoryAttributesCommandHandler,
+	copyHandler command.CopyCategoryAttributesCommandHandler,
+	getListHandler query.GetCategoryAttributeListQueryHandler,
+	getSchemaHandler query.GetCategorySchemaQueryHandler,
+	getAttrHandler query.GetAttributeByIDQueryHandler,
+	validateHandler query.ValidateProductAttributesQueryHandler,
+) *categoryAttributeHandler {
+	return &categoryAttributeHandler{
+		assignHandler:    assignHandler,
+		updateHandler:    updateHandler,
+		unassignHandler:  unassignHandler,
+		setHandler:       setHandler,
+		copyHandler:      copyHandler,
+		getListHandler:   getListHandler,
+		getSchemaHandler: getSchemaHandler,
+		getAttrHandler:   getAttrHandler,
+		validateHandler:  validateHandler,
+	}
+}
+
+func toOptBool(b *bool) httpapi.OptBool {
+	if b == nil {
+		return httpapi.OptBool{}
+	}
+	return httpapi.NewOptBool(*b)
+}
+
+func toCategoryAttributeResponse(view query.CategoryAttributeView) *httpapi.CategoryAttributeResponse {
+	ca := view.CategoryAttribute
+	return &httpapi.CategoryAttributeResponse{
+		ID:                  ca.ID,
+		Version:             ca.Version,
+		CategoryId:          ca.CategoryID,
+		AttributeId:         ca.AttributeID,
+		Required:            ca.Required,
+		SortOrder:           ca.SortOrder,
+		Filterable:          toOptBool(ca.Filterable),
+		Searchable:          toOptBool(ca.Searchable),
+		EffectiveFilterable: view.Filterable,
+		EffectiveSearchable: view.Searchable,
+		Enabled:             ca.Enabled,
+		GroupId:             toOptString(ca.GroupID),
+		GroupSortOrder:      ca.GroupSortOrder,
+		AllowedOptions:      ca.AllowedOptions,
+		DefaultValues:       ca.DefaultValues,
+		CreatedAt:           ca.CreatedAt,
+		ModifiedAt:          ca.ModifiedAt,
+	}
+}
+
+func toCategoryAttributeGroupResponse(view query.CategoryAttributeGroupView, _ int) httpapi.CategoryAttributeGroup {
+	group := httpapi.CategoryAttributeGroup{
+		Items: lo.Map(view.Items, func(item query.CategoryAttributeView, _ int) httpapi.CategoryAttributeResponse {
+			return *toCategoryAttributeResponse(item)
+		}),
+	}
+	if view.Group != nil {
+		group.Group = httpapi.NewOptAttributeGroupResponse(*toAttributeGroupResponse(view.Group))
+	}
+	return group
+}
+
+func toCategorySchemaAttributeResponse(item query.CategorySchemaAttribute, _ int) httpapi.CategorySchemaAttribute {
+	return httpapi.CategorySchemaAttribute{
+		AttributeId:         item.Attribute.ID,
+		CategoryAttributeId: item.Assignment.ID,
+		Name:                item.Attribute.Name,
+		Slug:                item.Attribute.Slug,
+		Type:                httpapi.CategorySchemaAttributeType(item.Attribute.Type),
+		Unit:                toOptString(item.Attribute.Unit),
+		Options:             lo.Map(item.Options, toAttributeOptionResponse),
+		Range:               toOptRangeConfig(item.Attribute.TypeConfig.Range),
+		Date:                toOptDateConfig(item.Attribute.TypeConfig.Date),
+		Dimension:           toOptDimensionConfig(item.Attribute.TypeConfig.Dimension),
+		Reference:           toOptReferenceConfig(item.Attribute.TypeConfig.Reference),
+		Text:                toOptTextConfig(item.Attribute.TypeConfig.Text),
+		SwatchMode:          httpapi.CategorySchemaAttributeSwatchMode(item.Attribute.TypeConfig.SwatchMode),
+		Required:            item.Assignment.Required,
+		SortOrder:           item.Assignment.SortOrder,
+		DefaultValues:       item.Assignment.DefaultValues,
+		Filterable:          item.Filterable,
+		Searchable:          item.Searchable,
+	}
+}
+
+func toGroupID(opt httpapi.OptUUID) *string {
+	if !opt.IsSet() {
+		return nil
+	}
+	return lo.ToPtr(opt.Value.String())
+}
+
+// toView resolves effective flags of a written assignment against its attribute
+func (h *categoryAttributeHandler) toView(ctx context.Context, ca *categoryattribute.CategoryAttribute) (query.CategoryAttributeView, error) {
+	a, err := h.getAttrHandler.Handle(ctx, query.GetAttributeByIDQuery{ID: ca.AttributeID})
+	if err != nil {
+		return query.CategoryAttributeView{}, err
+	}
+	return query.NewCategoryAttributeView(ca, a), nil
+}
+
+func (h *categoryAttributeHandler) AssignAttributeToCategory(ctx context.Context, req *httpapi.AssignAttributeToCategoryReq, params httpapi.AssignAttributeToCategoryParams) (httpapi.AssignAttributeToCategoryRes, error) {
+	var id *string
+	if req.ID.IsSet() {
+		id = lo.ToPtr(req.ID.Value.String())
+	}
+
+	cmd := command.AssignAttributeToCategoryCommand{
+		ID:             id,
+		CategoryID:     params.CategoryId,
+		AttributeID:    req.AttributeId.String(),
+		Required:       req.Required,
+		SortOrder:      req.SortOrder.Or(0),
+		Filterable:     lo.If(req.Filterable.IsSet(), &req.Filterable.Value).Else(nil),
+		Searchable:     lo.If(req.Searchable.IsSet(), &req.Searchable.Value).Else(nil),
+		Enabled:        req.Enabled,
+		GroupID:        toGroupID(req.GroupId),
+		GroupSortOrder: req.GroupSortOrder.Or(0),
+		AllowedOptions: req.AllowedOptions,
+		DefaultValues:  req.DefaultValues,
+	}
+
+	created, err := h.assignHandler.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, attribute.ErrUnknownOption) {
+			return &httpapi.AssignAttributeToCategoryBadRequest{
+				Status: 400,
+				Type:   *aboutBlankURL,
+				Title:  "Allowed option does not exist on the attribute",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, categoryattribute.ErrInvalidDefault) {
+			return &httpapi.AssignAttributeToCategoryBadRequest{
+				Status: 400,
+				Type:   *aboutBlankURL,
+				Title:  "Default value does not suit the attribute",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, attributegroup.ErrGroupNotFound) {
+			return &httpapi.AssignAttributeToCategoryNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute group not found",
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.AssignAttributeToCategoryNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute not found",
+			}, nil
+		}
+		if errors.Is(err, categoryattribute.ErrAlreadyAssigned) {
+			return &httpapi.AssignAttributeToCategoryConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute is already assigned to this category",
+			}, nil
+		}
+		if errors.Is(err, attribute.ErrAttributeNotAssignable) {
+			return &httpapi.AssignAttributeToCategoryConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute cannot be assigned in its current status",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		return nil, err
+	}
+
+	view, err := h.toView(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCategoryAttributeResponse(view), nil
+}
+
+func (h *categoryAttributeHandler) GetCategoryAttributeList(ctx context.Context, params httpapi.GetCategoryAttributeListParams) (httpapi.GetCategoryAttributeListRes, error) {
+	var enabled *bool
+	if params.Enabled.IsSet() {
+		enabled = &params.Enabled.Value
+	}
+
+	var filterable *bool
+	if params.Filterable.IsSet() {
+		filterable = &params.Filterable.Value
+	}
+
+	q := query.GetCategoryAttributeListQuery{
+		CategoryID: params.CategoryId,
+		Page:       params.Page,
+		Size:       params.Size,
+		Enabled:    enabled,
+		Filterable: filterable,
+		Sort:       string(params.Sort.Or(httpapi.GetCategoryAttributeListSortSortOrder)),
+		Order:      string(params.Order.Or(httpapi.GetCategoryAttributeListOrderAsc)),
+	}
+
+	result, err := h.getListHandler.Handle(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpapi.CategoryAttributeListResponse{
+		Items: lo.Map(result.Items, func(view query.CategoryAttributeView, _ int) httpapi.CategoryAttributeResponse {
+			return *toCategoryAttributeResponse(view)
+		}),
+		Groups: lo.Map(result.Groups, toCategoryAttributeGroupResponse),
+		Page:   result.Page,
+		Size:   result.Size,
+		Total:  int(result.Total),
+	}, nil
+}
+
+func (h *categoryAttributeHandler) GetCategorySchema(ctx context.Context, params httpapi.GetCategorySchemaParams) (httpapi.GetCategorySchemaRes, error) {
+	schema, err := h.getSchemaHandler.Handle(ctx, query.GetCategorySchemaQuery{
+		CategoryID:     params.CategoryId,
+		AcceptLanguage: params.AcceptLanguage.Or(""),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpapi.CategorySchemaResponse{
+		CategoryId: schema.CategoryID,
+		Attributes: lo.Map(schema.Attributes, toCategorySchemaAttributeResponse),
+	}, nil
+}
+
+func (h *categoryAttributeHandler) ValidateProductAttributes(ctx context.Context, req *httpapi.ValidateProductAttributesReq, params httpapi.ValidateProductAttributesParams) (httpapi.ValidateProductAttributesRes, error) {
+	result, err := h.validateHandler.Handle(ctx, query.ValidateProductAttributesQuery{
+		CategoryID: params.CategoryId,
+		Values:     req.Values,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpapi.ProductAttributesValidationResponse{
+		Valid: result.Valid,
+		Errors: lo.Map(result.Errors, func(e query.AttributeValueError, _ int) httpapi.AttributeValueError {
+			return httpapi.AttributeValueError{
+				Attribute: e.Attribute,
+				Code:      httpapi.AttributeValueErrorCode(e.Code),
+				Value:     toOptString(e.Value),
+				Message:   e.Message,
+			}
+		}),
+	}, nil
+}
+
+func (h *categoryAttributeHandler) UpdateCategoryAttribute(ctx context.Context, req *httpapi.UpdateCategoryAttributeReq, params httpapi.UpdateCategoryAttributeParams) (httpapi.UpdateCategoryAttributeRes, error) {
+	cmd := command.UpdateCategoryAttributeCommand{
+		ID:             params.ID,
+		CategoryID:     params.CategoryId,
+		Version:        req.Version,
+		Required:       req.Required,
+		SortOrder:      req.SortOrder,
+		Filterable:     lo.If(req.Filterable.IsSet(), &req.Filterable.Value).Else(nil),
+		Searchable:     lo.If(req.Searchable.IsSet(), &req.Searchable.Value).Else(nil),
+		Enabled:        req.Enabled,
+		GroupID:        toGroupID(req.GroupId),
+		GroupSortOrder: req.GroupSortOrder.Or(0),
+		AllowedOptions: req.AllowedOptions,
+		DefaultValues:  req.DefaultValues,
+	}
+
+	updated, err := h.updateHandler.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, attribute.ErrUnknownOption) {
+			return &httpapi.UpdateCategoryAttributeBadRequest{
+				Status: 400,
+				Type:   *aboutBlankURL,
+				Title:  "Allowed option does not exist on the attribute",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, categoryattribute.ErrInvalidDefault) {
+			return &httpapi.UpdateCategoryAttributeBadRequest{
+				Status: 400,
+				Type:   *aboutBlankURL,
+				Title:  "Default value does not suit the attribute",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, attributegroup.ErrGroupNotFound) {
+			return &httpapi.UpdateCategoryAttributeNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute group not found",
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.UpdateCategoryAttributeNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Category attribute not found",
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.UpdateCategoryAttributePreconditionFailed{
+				Status: 412,
+				Type:   *aboutBlankURL,
+				Title:  "Version mismatch",
+			}, nil
+		}
+		if errors.Is(err, categoryattribute.ErrAlreadyAssigned) {
+			return &httpapi.UpdateCategoryAttributeConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute is already assigned to this category",
+			}, nil
+		}
+		return nil, err
+	}
+
+	view, err := h.toView(ctx, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCategoryAttributeResponse(view), nil
+}
+
+func (h *categoryAttributeHandler) SetCategoryAttributes(ctx context.Context, req *httpapi.SetCategoryAttributesReq, params httpapi.SetCategoryAttributesParams) (httpapi.SetCategoryAttributesRes, error) {
+	cmd := command.SetCategoryAttributesCommand{
+		CategoryID: params.CategoryId,
+		Mode:       command.BulkMode(req.Mode),
+		Items: lo.Map(req.Items, func(item httpapi.BulkCategoryAttributeItem, _ int) command.BulkCategoryAttributeInput {
+			return command.BulkCategoryAttributeInput{
+				AttributeID:    item.AttributeId.String(),
+				Required:       item.Required,
+				Filterable:     lo.If(item.Filterable.IsSet(), &item.Filterable.Value).Else(nil),
+				Searchable:     lo.If(item.Searchable.IsSet(), &item.Searchable.Value).Else(nil),
+				Enabled:        item.Enabled,
+				GroupID:        toGroupID(item.GroupId),
+				GroupSortOrder: item.GroupSortOrder.Or(0),
+				AllowedOptions: item.AllowedOptions,
+				DefaultValues:  item.DefaultValues,
+			}
+		}),
+	}
+
+	assignments, err := h.setHandler.Handle(ctx, cmd)
+	if err != nil {
+		var itemsErr *command.InvalidBulkItemsError
+		if errors.As(err, &itemsErr) {
+			return &httpapi.SetCategoryAttributesUnprocessableEntity{
+				Status: 422,
+				Type:   *aboutBlankURL,
+				Title:  "Some items were rejected",
+				Errors: lo.Map(itemsErr.Items, func(e command.BulkItemError, _ int) httpapi.BulkItemError {
+					return httpapi.BulkItemError{
+						Index:       e.Index,
+						AttributeId: e.AttributeID,
+						Message:     e.Message,
+					}
+				}),
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.SetCategoryAttributesConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Category attributes were modified concurrently",
+			}, nil
+		}
+		return nil, err
+	}
+
+	items := make([]httpapi.CategoryAttributeResponse, 0, len(assignments))
+	for _, ca := range assignments {
+		view, err := h.toView(ctx, ca)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *toCategoryAttributeResponse(view))
+	}
+
+	return &httpapi.SetCategoryAttributesResponse{
+		CategoryId: params.CategoryId,
+		Items:      items,
+	}, nil
+}
+
+func (h *categoryAttributeHandler) CopyCategoryAttributes(ctx context.Context, req *httpapi.CopyCategoryAttributesReq, params httpapi.CopyCategoryAttributesParams) (httpapi.CopyCategoryAttributesRes, error) {
+	cmd := command.CopyCategoryAttributesCommand{
+		SourceCategoryID: req.SourceCategoryId,
+		TargetCategoryID: params.CategoryId,
+		OnConflict:       command.CopyConflictStrategy(req.OnConflict.Or(httpapi.CopyCategoryAttributesReqOnConflictSkip)),
+	}
+
+	result, err := h.copyHandler.Handle(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, categoryattribute.ErrSameCategory) {
+			return &httpapi.CopyCategoryAttributesBadRequest{
+				Status: 400,
+				Type:   *aboutBlankURL,
+				Title:  "Source and target categories must differ",
+			}, nil
+		}
+		if errors.Is(err, categoryattribute.ErrAlreadyAssigned) {
+			return &httpapi.CopyCategoryAttributesConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute is already assigned to the target category",
+				Detail: httpapi.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.CopyCategoryAttributesConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Category attributes were modified concurrently",
+			}, nil
+		}
+		return nil, err
+	}
+
+	toResponses := func(assignments []*categoryattribute.CategoryAttribute) ([]httpapi.CategoryAttributeResponse, error) {
+		items := make([]httpapi.CategoryAttributeResponse, 0, len(assignments))
+		for _, ca := range assignments {
+			view, err := h.toView(ctx, ca)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, *toCategoryAttributeResponse(view))
+		}
+		return items, nil
+	}
+
+	copied, err := toResponses(result.Copied)
+	if err != nil {
+		return nil, err
+	}
+	overwritten, err := toResponses(result.Overwritten)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpapi.CopyCategoryAttributesResponse{
+		SourceCategoryId:    cmd.SourceCategoryID,
+		TargetCategoryId:    cmd.TargetCategoryID,
+		Copied:              copied,
+		Overwritten:         overwritten,
+		SkippedAttributeIds: result.Skipped,
+	}, nil
+}
+
+func (h *categoryAttributeHandler) UnassignAttributeFromCategory(ctx context.Context, params httpapi.UnassignAttributeFromCategoryParams) (httpapi.UnassignAttributeFromCategoryRes, error) {
+	cmd := command.UnassignAttributeFromCategoryCommand{
+		ID:         params.ID,
+		CategoryID: params.CategoryId,
+	}
+
+	if err := h.unassignHandler.Handle(ctx, cmd); err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.UnassignAttributeFromCategoryNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Category attribute not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &httpapi.UnassignAttributeFromCategoryNoContent{}, nil
+}
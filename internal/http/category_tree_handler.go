@@ -0,0 +1,112 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service-api/gen/httpapi"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/application/command"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/application/query"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+)
+
+type categoryTreeHandler struct {
+	excludeHandler      command.ExcludeInheritedAttributeCommandHandler
+	includeHandler      command.IncludeInheritedAttributeCommandHandler
+	getEffectiveHandler query.GetEffectiveCategoryAttributesQueryHandler
+}
+
+func newCategoryTreeHandler(
+	excludeHandler command.ExcludeInheritedAttributeCommandHandler,
+	includeHandler command.IncludeInheritedAttributeCommandHandler,
+	getEffectiveHandler query.GetEffectiveCategoryAttributesQueryHandler,
+) *categoryTreeHandler {
+	return &categoryTreeHandler{
+		excludeHandler:      excludeHandler,
+		includeHandler:      includeHandler,
+		getEffectiveHandler: getEffectiveHandler,
+	}
+}
+
+func toCategoryAttributeExclusionsResponse(node *categorytree.Node) *httpapi.CategoryAttributeExclusionsResponse {
+	return &httpapi.CategoryAttributeExclusionsResponse{
+		CategoryId:           node.ID,
+		ExcludedAttributeIds: node.ExcludedAttributeIDs,
+	}
+}
+
+func (h *categoryTreeHandler) GetEffectiveCategoryAttributes(ctx context.Context, params httpapi.GetEffectiveCategoryAttributesParams) (httpapi.GetEffectiveCategoryAttributesRes, error) {
+	result, err := h.getEffectiveHandler.Handle(ctx, query.GetEffectiveCategoryAttributesQuery{
+		CategoryID: params.CategoryId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpapi.EffectiveCategoryAttributesResponse{
+		CategoryId: result.CategoryID,
+		Items: lo.Map(result.Items, func(item query.EffectiveCategoryAttribute, _ int) httpapi.EffectiveCategoryAttribute {
+			return httpapi.EffectiveCategoryAttribute{
+				Assignment:       *toCategoryAttributeResponse(item.CategoryAttributeView),
+				Inherited:        item.Inherited,
+				SourceCategoryId: item.CategoryAttribute.CategoryID,
+			}
+		}),
+		ExcludedAttributeIds: result.ExcludedAttributeIDs,
+	}, nil
+}
+
+func (h *categoryTreeHandler) ExcludeInheritedAttribute(ctx context.Context, req *httpapi.ExcludeInheritedAttributeReq, params httpapi.ExcludeInheritedAttributeParams) (httpapi.ExcludeInheritedAttributeRes, error) {
+	node, err := h.excludeHandler.Handle(ctx, command.ExcludeInheritedAttributeCommand{
+		CategoryID:  params.CategoryId,
+		AttributeID: req.AttributeId.String(),
+	})
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.ExcludeInheritedAttributeNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute not found",
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.ExcludeInheritedAttributeConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Category was modified concurrently",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return toCategoryAttributeExclusionsResponse(node), nil
+}
+
+func (h *categoryTreeHandler) IncludeInheritedAttribute(ctx context.Context, params httpapi.IncludeInheritedAttributeParams) (httpapi.IncludeInheritedAttributeRes, error) {
+	err := h.includeHandler.Handle(ctx, command.IncludeInheritedAttributeCommand{
+		CategoryID:  params.CategoryId,
+		AttributeID: params.AttributeId,
+	})
+	if err != nil {
+		if errors.Is(err, persistence.ErrEntityNotFound) {
+			return &httpapi.IncludeInheritedAttributeNotFound{
+				Status: 404,
+				Type:   *aboutBlankURL,
+				Title:  "Attribute exclusion not found",
+			}, nil
+		}
+		if errors.Is(err, persistence.ErrOptimisticLocking) {
+			return &httpapi.IncludeInheritedAttributeConflict{
+				Status: 409,
+				Type:   *aboutBlankURL,
+				Title:  "Category was modified concurrently",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &httpapi.IncludeInheritedAttributeNoContent{}, nil
+}
@@ -0,0 +1,27 @@
+package http
+
+import (
+	"github.com/Sokol111/ecommerce-attribute-service-api/gen/httpapi"
+)
+
+// handler combines resource handlers into a single httpapi.Handler implementation
+type handler struct {
+	*attributeHandler
+	*attributeGroupHandler
+	*categoryAttributeHandler
+	*categoryTreeHandler
+}
+
+func newHandler(
+	attributeHandler *attributeHandler,
+	attributeGroupHandler *attributeGroupHandler,
+	categoryAttributeHandler *categoryAttributeHandler,
+	categoryTreeHandler *categoryTreeHandler,
+) httpapi.Handler {
+	return &handler{
+		attributeHandler:         attributeHandler,
+		attributeGroupHandler:    attributeGroupHandler,
+		categoryAttributeHandler: categoryAttributeHandler,
+		categoryTreeHandler:      categoryTreeHandler,
+	}
+}
@@ -16,6 +16,10 @@ func NewHttpHandlerModule() fx.Option {
 	return fx.Options(
 		fx.Provide(
 			newAttributeHandler,
+			newAttributeGroupHandler,
+			newCategoryAttributeHandler,
+			newCategoryTreeHandler,
+			newHandler,
 			newOgenServer,
 		),
 		fx.Invoke(registerOgenRoutes),
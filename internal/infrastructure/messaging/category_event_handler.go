@@ -0,0 +1,114 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/application/command"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+	"github.com/Sokol111/ecommerce-attribute-service/internal/event"
+	"github.com/Sokol111/ecommerce-commons/pkg/core/logger"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/consumer"
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/events"
+)
+
+var errMissingCategoryID = errors.New("category id is missing")
+
+// categoryEventHandler keeps category assignments in sync with the category service
+type categoryEventHandler struct {
+	syncParentHandler command.SyncCategoryParentCommandHandler
+	removeHandler     command.RemoveCategoryAssignmentsCommandHandler
+	disableHandler    command.DisableCategoryAssignmentsCommandHandler
+}
+
+func newCategoryEventHandler(
+	syncParentHandler command.SyncCategoryParentCommandHandler,
+	removeHandler command.RemoveCategoryAssignmentsCommandHandler,
+	disableHandler command.DisableCategoryAssignmentsCommandHandler,
+) *categoryEventHandler {
+	return &categoryEventHandler{
+		syncParentHandler: syncParentHandler,
+		removeHandler:     removeHandler,
+		disableHandler:    disableHandler,
+	}
+}
+
+func (h *categoryEventHandler) Process(ctx context.Context, e any) error {
+	switch ev := e.(type) {
+	case *event.CategoryCreatedEvent:
+		return h.syncParent(ctx, ev.Metadata, ev.Payload.CategoryID, ev.Payload.ParentID, ev.Payload.Version)
+	case *event.CategoryUpdatedEvent:
+		return h.syncParent(ctx, ev.Metadata, ev.Payload.CategoryID, ev.Payload.ParentID, ev.Payload.Version)
+	case *event.CategoryDeletedEvent:
+		return h.handleCategoryDeleted(ctx, ev)
+	case *event.CategoryDisabledEvent:
+		return h.handleCategoryDisabled(ctx, ev)
+	default:
+		return consumer.ErrSkipMessage
+	}
+}
+
+func (h *categoryEventHandler) syncParent(ctx context.Context, metadata events.EventMetadata, categoryID string, parentID *string, version int) error {
+	if categoryID == "" {
+		return fmt.Errorf("invalid %s event %s: %w: %w", metadata.EventType, metadata.EventID, errMissingCategoryID, consumer.ErrPermanent)
+	}
+
+	err := h.syncParentHandler.Handle(ctx, command.SyncCategoryParentCommand{
+		CategoryID:      categoryID,
+		ParentID:        parentID,
+		CategoryVersion: version,
+	})
+	if errors.Is(err, categorytree.ErrCyclicParent) {
+		return fmt.Errorf("invalid %s event %s: %w: %w", metadata.EventType, metadata.EventID, err, consumer.ErrPermanent)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to sync category parent: %w", err)
+	}
+
+	logger.Get(ctx).Debug("category parent synced",
+		zap.String("categoryId", categoryID),
+		zap.Stringp("parentId", parentID),
+		zap.String("eventId", metadata.EventID))
+
+	return nil
+}
+
+func (h *categoryEventHandler) handleCategoryDeleted(ctx context.Context, e *event.CategoryDeletedEvent) error {
+	if e.Payload.CategoryID == "" {
+		return fmt.Errorf("invalid %s event %s: %w: %w", e.Metadata.EventType, e.Metadata.EventID, errMissingCategoryID, consumer.ErrPermanent)
+	}
+
+	if err := h.removeHandler.Handle(ctx, command.RemoveCategoryAssignmentsCommand{
+		CategoryID:      e.Payload.CategoryID,
+		CategoryVersion: e.Payload.Version,
+	}); err != nil {
+		return fmt.Errorf("failed to remove category assignments: %w", err)
+	}
+
+	logger.Get(ctx).Info("category assignments removed",
+		zap.String("categoryId", e.Payload.CategoryID),
+		zap.String("eventId", e.Metadata.EventID))
+
+	return nil
+}
+
+func (h *categoryEventHandler) handleCategoryDisabled(ctx context.Context, e *event.CategoryDisabledEvent) error {
+	if e.Payload.CategoryID == "" {
+		return fmt.Errorf("invalid %s event %s: %w: %w", e.Metadata.EventType, e.Metadata.EventID, errMissingCategoryID, consumer.ErrPermanent)
+	}
+
+	if err := h.disableHandler.Handle(ctx, command.DisableCategoryAssignmentsCommand{
+		CategoryID: e.Payload.CategoryID,
+	}); err != nil {
+		return fmt.Errorf("failed to disable category assignments: %w", err)
+	}
+
+	logger.Get(ctx).Info("category assignments disabled",
+		zap.String("categoryId", e.Payload.CategoryID),
+		zap.String("eventId", e.Metadata.EventID))
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package messaging
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-commons/pkg/messaging/kafka/consumer"
+)
+
+// Module registers Kafka consumers of the service
+func Module() fx.Option {
+	return fx.Options(
+		// Malformed messages are routed to the DLQ configured for the consumer
+		consumer.RegisterHandlerAndConsumer("category-event-consumer", newCategoryEventHandler),
+	)
+}
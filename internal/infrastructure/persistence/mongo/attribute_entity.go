@@ -6,23 +6,89 @@ import (
 
 // optionEntity represents an embedded attribute option in MongoDB
 type optionEntity struct {
-	Name      string  `bson:"name"`
-	Slug      string  `bson:"slug"`
-	ColorCode *string `bson:"colorCode,omitempty"`
-	SortOrder int     `bson:"sortOrder"`
-	Enabled   bool    `bson:"enabled"`
+	Name          string             `bson:"name"`
+	Slug          string             `bson:"slug"`
+	ColorCode     *string            `bson:"colorCode,omitempty"`
+	Image         *optionImageEntity `bson:"image,omitempty"`
+	SortOrder     int                `bson:"sortOrder"`
+	Enabled       bool               `bson:"enabled"`
+	Translations  map[string]string  `bson:"translations,omitempty"`
+	PreviousSlugs []string           `bson:"previousSlugs,omitempty"`
+}
+
+// optionImageEntity represents an image swatch of an option in MongoDB
+type optionImageEntity struct {
+	URL string `bson:"url"`
+	Alt string `bson:"alt"`
+}
+
+// translationEntity represents locale-specific attribute texts in MongoDB
+type translationEntity struct {
+	Name string  `bson:"name"`
+	Unit *string `bson:"unit,omitempty"`
+}
+
+// rangeEntity represents an embedded range configuration in MongoDB
+type rangeEntity struct {
+	Min       float64  `bson:"min"`
+	Max       float64  `bson:"max"`
+	Step      *float64 `bson:"step,omitempty"`
+	Precision *int     `bson:"precision,omitempty"`
+}
+
+// dateEntity represents embedded date bounds in MongoDB
+type dateEntity struct {
+	Min *string `bson:"min,omitempty"`
+	Max *string `bson:"max,omitempty"`
+}
+
+// dimensionComponentEntity represents a component of an embedded dimension configuration in MongoDB
+type dimensionComponentEntity struct {
+	Key  string  `bson:"key"`
+	Unit *string `bson:"unit,omitempty"`
+	Min  float64 `bson:"min"`
+	Max  float64 `bson:"max"`
+}
+
+// dimensionEntity represents an embedded dimension configuration in MongoDB
+type dimensionEntity struct {
+	Components []dimensionComponentEntity `bson:"components"`
+}
+
+// textEntity represents embedded text constraints in MongoDB
+type textEntity struct {
+	MinLength *int    `bson:"minLength,omitempty"`
+	MaxLength *int    `bson:"maxLength,omitempty"`
+	Pattern   *string `bson:"pattern,omitempty"`
+	Multiline bool    `bson:"multiline"`
+}
+
+// referenceEntity represents an embedded reference configuration in MongoDB
+type referenceEntity struct {
+	TargetKind string `bson:"targetKind"`
 }
 
 // attributeEntity represents the MongoDB document structure
 type attributeEntity struct {
-	ID         string         `bson:"_id"`
-	Version    int            `bson:"version"`
-	Name       string         `bson:"name"`
-	Slug       string         `bson:"slug"`
-	Type       string         `bson:"type"`
-	Unit       *string        `bson:"unit,omitempty"`
-	Enabled    bool           `bson:"enabled"`
-	Options    []optionEntity `bson:"options,omitempty"`
-	CreatedAt  time.Time      `bson:"createdAt"`
-	ModifiedAt time.Time      `bson:"modifiedAt"`
+	ID            string                       `bson:"_id"`
+	Version       int                          `bson:"version"`
+	Name          string                       `bson:"name"`
+	Slug          string                       `bson:"slug"`
+	PreviousSlugs []string                     `bson:"previousSlugs,omitempty"`
+	Status        string                       `bson:"status"`
+	Type          string                       `bson:"type"`
+	Unit          *string                      `bson:"unit,omitempty"`
+	Enabled       bool                         `bson:"enabled"`
+	Filterable    bool                         `bson:"filterable"`
+	Searchable    bool                         `bson:"searchable"`
+	Options       []optionEntity               `bson:"options,omitempty"`
+	Range         *rangeEntity                 `bson:"range,omitempty"`
+	Date          *dateEntity                  `bson:"date,omitempty"`
+	Dimension     *dimensionEntity             `bson:"dimension,omitempty"`
+	Reference     *referenceEntity             `bson:"reference,omitempty"`
+	Text          *textEntity                  `bson:"text,omitempty"`
+	SwatchMode    string                       `bson:"swatchMode,omitempty"`
+	Translations  map[string]translationEntity `bson:"translations,omitempty"`
+	CreatedAt     time.Time                    `bson:"createdAt"`
+	ModifiedAt    time.Time                    `bson:"modifiedAt"`
 }
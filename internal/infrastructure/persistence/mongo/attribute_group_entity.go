@@ -0,0 +1,16 @@
+package mongo
+
+import (
+	"time"
+)
+
+// attributeGroupEntity represents the MongoDB document structure for attribute groups
+type attributeGroupEntity struct {
+	ID         string    `bson:"_id"`
+	Version    int       `bson:"version"`
+	Name       string    `bson:"name"`
+	Slug       string    `bson:"slug"`
+	SortOrder  int       `bson:"sortOrder"`
+	CreatedAt  time.Time `bson:"createdAt"`
+	ModifiedAt time.Time `bson:"modifiedAt"`
+}
@@ -0,0 +1,47 @@
+package mongo
+
+import (
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
+)
+
+type attributeGroupMapper struct{}
+
+func newAttributeGroupMapper() *attributeGroupMapper {
+	return &attributeGroupMapper{}
+}
+
+func (m *attributeGroupMapper) ToEntity(g *attributegroup.AttributeGroup) *attributeGroupEntity {
+	return &attributeGroupEntity{
+		ID:         g.ID,
+		Version:    g.Version,
+		Name:       g.Name,
+		Slug:       g.Slug,
+		SortOrder:  g.SortOrder,
+		CreatedAt:  g.CreatedAt,
+		ModifiedAt: g.ModifiedAt,
+	}
+}
+
+func (m *attributeGroupMapper) ToDomain(e *attributeGroupEntity) *attributegroup.AttributeGroup {
+	return attributegroup.Reconstruct(
+		e.ID,
+		e.Version,
+		e.Name,
+		e.Slug,
+		e.SortOrder,
+		e.CreatedAt.UTC(),
+		e.ModifiedAt.UTC(),
+	)
+}
+
+func (m *attributeGroupMapper) GetID(e *attributeGroupEntity) string {
+	return e.ID
+}
+
+func (m *attributeGroupMapper) GetVersion(e *attributeGroupEntity) int {
+	return e.Version
+}
+
+func (m *attributeGroupMapper) SetVersion(e *attributeGroupEntity, version int) {
+	e.Version = version
+}
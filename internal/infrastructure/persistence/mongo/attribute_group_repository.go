@@ -0,0 +1,127 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attributegroup"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type attributeGroupRepository struct {
+	*commonsmongo.GenericRepository[attributegroup.AttributeGroup, attributeGroupEntity]
+	collection commonsmongo.Collection
+	mapper     *attributeGroupMapper
+}
+
+func newAttributeGroupRepository(mongoClient commonsmongo.Mongo, mapper *attributeGroupMapper) (attributegroup.Repository, error) {
+	collection := mongoClient.GetCollection("attribute_group")
+
+	genericRepo, err := commonsmongo.NewGenericRepository(
+		collection,
+		mapper,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &attributeGroupRepository{
+		GenericRepository: genericRepo,
+		collection:        collection,
+		mapper:            mapper,
+	}, nil
+}
+
+func (r *attributeGroupRepository) FindByIDs(ctx context.Context, ids []string) ([]*attributegroup.AttributeGroup, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attribute groups: %w", err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var entities []attributeGroupEntity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode attribute groups: %w", err)
+	}
+
+	items := make([]*attributegroup.AttributeGroup, 0, len(entities))
+	for i := range entities {
+		items = append(items, r.mapper.ToDomain(&entities[i]))
+	}
+	return items, nil
+}
+
+func (r *attributeGroupRepository) FindList(ctx context.Context, query attributegroup.ListQuery) (*commonsmongo.PageResult[attributegroup.AttributeGroup], error) {
+	var sortBson bson.D
+	if query.Sort != "" {
+		sortOrder := 1 // asc
+		if query.Order == "desc" {
+			sortOrder = -1
+		}
+		sortBson = bson.D{{Key: query.Sort, Value: sortOrder}}
+	}
+
+	opts := commonsmongo.QueryOptions{
+		Filter: bson.D{},
+		Page:   query.Page,
+		Size:   query.Size,
+		Sort:   sortBson,
+	}
+
+	return r.FindWithOptions(ctx, opts)
+}
+
+// Override Insert to handle duplicate slug error
+func (r *attributeGroupRepository) Insert(ctx context.Context, g *attributegroup.AttributeGroup) error {
+	err := r.GenericRepository.Insert(ctx, g)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return attributegroup.ErrSlugAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// Override Update to handle duplicate slug error
+func (r *attributeGroupRepository) Update(ctx context.Context, g *attributegroup.AttributeGroup) (*attributegroup.AttributeGroup, error) {
+	result, err := r.GenericRepository.Update(ctx, g)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, attributegroup.ErrSlugAlreadyExists
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete removes the group only if the stored version matches
+func (r *attributeGroupRepository) Delete(ctx context.Context, id string, version int) error {
+	result, err := r.collection.DeleteOne(ctx, bson.D{
+		{Key: "_id", Value: id},
+		{Key: "version", Value: version},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete attribute group: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		exists, err := r.Exists(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return persistence.ErrEntityNotFound
+		}
+		return persistence.ErrOptimisticLocking
+	}
+
+	return nil
+}
@@ -15,53 +15,202 @@ func newAttributeMapper() *attributeMapper {
 func (m *attributeMapper) ToEntity(a *attribute.Attribute) *attributeEntity {
 	options := lo.Map(a.Options, func(opt attribute.Option, _ int) optionEntity {
 		return optionEntity{
-			Name:      opt.Name,
-			Slug:      opt.Slug,
-			ColorCode: opt.ColorCode,
-			SortOrder: opt.SortOrder,
-			Enabled:   opt.Enabled,
+			Name:          opt.Name,
+			Slug:          opt.Slug,
+			ColorCode:     opt.ColorCode,
+			Image:         toOptionImageEntity(opt.Image),
+			SortOrder:     opt.SortOrder,
+			Enabled:       opt.Enabled,
+			Translations:  opt.Translations,
+			PreviousSlugs: opt.PreviousSlugs,
 		}
 	})
 
+	translations := lo.MapValues(a.Translations, func(t attribute.Translation, _ string) translationEntity {
+		return translationEntity{
+			Name: t.Name,
+			Unit: t.Unit,
+		}
+	})
+
+	var rangeCfg *rangeEntity
+	if a.TypeConfig.Range != nil {
+		rangeCfg = &rangeEntity{
+			Min:       a.TypeConfig.Range.Min,
+			Max:       a.TypeConfig.Range.Max,
+			Step:      a.TypeConfig.Range.Step,
+			Precision: a.TypeConfig.Range.Precision,
+		}
+	}
+
+	var dateCfg *dateEntity
+	if a.TypeConfig.Date != nil {
+		dateCfg = &dateEntity{
+			Min: a.TypeConfig.Date.Min,
+			Max: a.TypeConfig.Date.Max,
+		}
+	}
+
+	var dimensionCfg *dimensionEntity
+	if a.TypeConfig.Dimension != nil {
+		dimensionCfg = &dimensionEntity{
+			Components: lo.Map(a.TypeConfig.Dimension.Components, func(c attribute.DimensionComponent, _ int) dimensionComponentEntity {
+				return dimensionComponentEntity{
+					Key:  c.Key,
+					Unit: c.Unit,
+					Min:  c.Min,
+					Max:  c.Max,
+				}
+			}),
+		}
+	}
+
+	var referenceCfg *referenceEntity
+	if a.TypeConfig.Reference != nil {
+		referenceCfg = &referenceEntity{TargetKind: a.TypeConfig.Reference.TargetKind}
+	}
+
+	var textCfg *textEntity
+	if a.TypeConfig.Text != nil {
+		textCfg = &textEntity{
+			MinLength: a.TypeConfig.Text.MinLength,
+			MaxLength: a.TypeConfig.Text.MaxLength,
+			Pattern:   a.TypeConfig.Text.Pattern,
+			Multiline: a.TypeConfig.Text.Multiline,
+		}
+	}
+
 	return &attributeEntity{
-		ID:         a.ID,
-		Version:    a.Version,
-		Name:       a.Name,
-		Slug:       a.Slug,
-		Type:       string(a.Type),
-		Unit:       a.Unit,
-		Enabled:    a.Enabled,
-		Options:    options,
-		CreatedAt:  a.CreatedAt,
-		ModifiedAt: a.ModifiedAt,
+		ID:            a.ID,
+		Version:       a.Version,
+		Name:          a.Name,
+		Slug:          a.Slug,
+		PreviousSlugs: a.PreviousSlugs,
+		Status:        string(a.Status),
+		Type:          string(a.Type),
+		Unit:          a.Unit,
+		Enabled:       a.Enabled,
+		Filterable:    a.Filterable,
+		Searchable:    a.Searchable,
+		Options:       options,
+		Range:         rangeCfg,
+		Date:          dateCfg,
+		Dimension:     dimensionCfg,
+		Reference:     referenceCfg,
+		Text:          textCfg,
+		SwatchMode:    string(a.TypeConfig.SwatchMode),
+		Translations:  translations,
+		CreatedAt:     a.CreatedAt,
+		ModifiedAt:    a.ModifiedAt,
 	}
 }
 
 func (m *attributeMapper) ToDomain(e *attributeEntity) *attribute.Attribute {
 	options := lo.Map(e.Options, func(opt optionEntity, _ int) attribute.Option {
 		return attribute.Option{
-			Name:      opt.Name,
-			Slug:      opt.Slug,
-			ColorCode: opt.ColorCode,
-			SortOrder: opt.SortOrder,
-			Enabled:   opt.Enabled,
+			Name:          opt.Name,
+			Slug:          opt.Slug,
+			ColorCode:     opt.ColorCode,
+			Image:         toOptionImage(opt.Image),
+			SortOrder:     opt.SortOrder,
+			Enabled:       opt.Enabled,
+			Translations:  opt.Translations,
+			PreviousSlugs: opt.PreviousSlugs,
 		}
 	})
 
+	translations := lo.MapValues(e.Translations, func(t translationEntity, _ string) attribute.Translation {
+		return attribute.Translation{
+			Name: t.Name,
+			Unit: t.Unit,
+		}
+	})
+
+	// documents written before swatch modes render options as text
+	typeConfig := attribute.TypeConfig{SwatchMode: attribute.SwatchMode(e.SwatchMode)}
+	if typeConfig.SwatchMode == "" {
+		typeConfig.SwatchMode = attribute.SwatchModeText
+	}
+	if e.Range != nil {
+		typeConfig.Range = &attribute.RangeConfig{
+			Min:       e.Range.Min,
+			Max:       e.Range.Max,
+			Step:      e.Range.Step,
+			Precision: e.Range.Precision,
+		}
+	}
+	if e.Date != nil {
+		typeConfig.Date = &attribute.DateConfig{
+			Min: e.Date.Min,
+			Max: e.Date.Max,
+		}
+	}
+	if e.Dimension != nil {
+		typeConfig.Dimension = &attribute.DimensionConfig{
+			Components: lo.Map(e.Dimension.Components, func(c dimensionComponentEntity, _ int) attribute.DimensionComponent {
+				return attribute.DimensionComponent{
+					Key:  c.Key,
+					Unit: c.Unit,
+					Min:  c.Min,
+					Max:  c.Max,
+				}
+			}),
+		}
+	}
+
+	if e.Reference != nil {
+		typeConfig.Reference = &attribute.ReferenceConfig{TargetKind: e.Reference.TargetKind}
+	}
+
+	if e.Text != nil {
+		typeConfig.Text = &attribute.TextConfig{
+			MinLength: e.Text.MinLength,
+			MaxLength: e.Text.MaxLength,
+			Pattern:   e.Text.Pattern,
+			Multiline: e.Text.Multiline,
+		}
+	}
+
+	// documents written before the status lifecycle are active
+	status := attribute.AttributeStatus(e.Status)
+	if status == "" {
+		status = attribute.AttributeStatusActive
+	}
+
 	return attribute.Reconstruct(
 		e.ID,
 		e.Version,
 		e.Name,
 		e.Slug,
+		e.PreviousSlugs,
+		status,
 		attribute.AttributeType(e.Type),
 		e.Unit,
 		e.Enabled,
+		e.Filterable,
+		e.Searchable,
 		options,
+		typeConfig,
+		translations,
 		e.CreatedAt.UTC(),
 		e.ModifiedAt.UTC(),
 	)
 }
 
+func toOptionImageEntity(image *attribute.OptionImage) *optionImageEntity {
+	if image == nil {
+		return nil
+	}
+	return &optionImageEntity{URL: image.URL, Alt: image.Alt}
+}
+
+func toOptionImage(image *optionImageEntity) *attribute.OptionImage {
+	if image == nil {
+		return nil
+	}
+	return &attribute.OptionImage{URL: image.URL, Alt: image.Alt}
+}
+
 func (m *attributeMapper) GetID(e *attributeEntity) string {
 	return e.ID
 }
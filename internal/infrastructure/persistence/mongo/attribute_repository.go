@@ -2,16 +2,21 @@ package mongo
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
 	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type attributeRepository struct {
 	*commonsmongo.GenericRepository[attribute.Attribute, attributeEntity]
 	collection commonsmongo.Collection
+	mapper     *attributeMapper
 }
 
 func newAttributeRepository(mongoClient commonsmongo.Mongo, mapper *attributeMapper) (attribute.Repository, error) {
@@ -28,6 +33,7 @@ func newAttributeRepository(mongoClient commonsmongo.Mongo, mapper *attributeMap
 	return &attributeRepository{
 		GenericRepository: genericRepo,
 		collection:        collection,
+		mapper:            mapper,
 	}, nil
 }
 
@@ -39,6 +45,11 @@ func (r *attributeRepository) FindList(ctx context.Context, query attribute.List
 	if query.Type != nil {
 		filter = append(filter, bson.E{Key: "type", Value: *query.Type})
 	}
+	if query.Status != nil {
+		filter = append(filter, bson.E{Key: "status", Value: *query.Status})
+	} else {
+		filter = append(filter, bson.E{Key: "status", Value: bson.D{{Key: "$ne", Value: string(attribute.AttributeStatusArchived)}}})
+	}
 
 	var sortBson bson.D
 	if query.Sort != "" {
@@ -59,6 +70,50 @@ func (r *attributeRepository) FindList(ctx context.Context, query attribute.List
 	return r.FindWithOptions(ctx, opts)
 }
 
+func (r *attributeRepository) FindByIDs(ctx context.Context, ids []string) ([]*attribute.Attribute, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attributes: %w", err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var entities []attributeEntity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode attributes: %w", err)
+	}
+
+	items := make([]*attribute.Attribute, 0, len(entities))
+	for i := range entities {
+		items = append(items, r.mapper.ToDomain(&entities[i]))
+	}
+	return items, nil
+}
+
+// FindBySlug looks the slug up through the attribute_slug_unique_v1 index
+func (r *attributeRepository) FindBySlug(ctx context.Context, slug string) (*attribute.Attribute, error) {
+	return r.findOne(ctx, bson.D{{Key: "slug", Value: slug}})
+}
+
+func (r *attributeRepository) FindByPreviousSlug(ctx context.Context, slug string) (*attribute.Attribute, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "modifiedAt", Value: -1}})
+	return r.findOne(ctx, bson.D{{Key: "previousSlugs", Value: slug}}, opts)
+}
+
+func (r *attributeRepository) findOne(ctx context.Context, filter bson.D, opts ...*options.FindOneOptions) (*attribute.Attribute, error) {
+	var entity attributeEntity
+	if err := r.collection.FindOne(ctx, filter, opts...).Decode(&entity); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, persistence.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to decode attribute: %w", err)
+	}
+	return r.mapper.ToDomain(&entity), nil
+}
+
 // Override Insert to handle duplicate slug error
 func (r *attributeRepository) Insert(ctx context.Context, a *attribute.Attribute) error {
 	err := r.GenericRepository.Insert(ctx, a)
@@ -82,3 +137,47 @@ func (r *attributeRepository) Update(ctx context.Context, a *attribute.Attribute
 	}
 	return result, nil
 }
+
+// LockForAssignment bumps a counter kept outside the attribute entity; replacing the
+// document on update drops it again, which is fine as only the write itself matters
+func (r *attributeRepository) LockForAssignment(ctx context.Context, id string) (*attribute.Attribute, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	result := r.collection.FindOneAndUpdate(ctx,
+		bson.D{{Key: "_id", Value: id}},
+		bson.D{{Key: "$inc", Value: bson.D{{Key: "assignmentLock", Value: 1}}}},
+		opts,
+	)
+
+	var entity attributeEntity
+	if err := result.Decode(&entity); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, persistence.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to lock attribute: %w", err)
+	}
+	return r.mapper.ToDomain(&entity), nil
+}
+
+// Delete removes the attribute only if the stored version matches
+func (r *attributeRepository) Delete(ctx context.Context, id string, version int) error {
+	result, err := r.collection.DeleteOne(ctx, bson.D{
+		{Key: "_id", Value: id},
+		{Key: "version", Value: version},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete attribute: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		exists, err := r.Exists(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return persistence.ErrEntityNotFound
+		}
+		return persistence.ErrOptimisticLocking
+	}
+
+	return nil
+}
@@ -6,15 +6,20 @@ import (
 
 // categoryAttributeEntity represents the MongoDB document structure for category-attribute assignments
 type categoryAttributeEntity struct {
-	ID          string    `bson:"_id"`
-	Version     int       `bson:"version"`
-	CategoryID  string    `bson:"categoryId"`
-	AttributeID string    `bson:"attributeId"`
-	Required    bool      `bson:"required"`
-	SortOrder   int       `bson:"sortOrder"`
-	Filterable  *bool     `bson:"filterable,omitempty"`
-	Searchable  *bool     `bson:"searchable,omitempty"`
-	Enabled     bool      `bson:"enabled"`
-	CreatedAt   time.Time `bson:"createdAt"`
-	ModifiedAt  time.Time `bson:"modifiedAt"`
+	ID               string    `bson:"_id"`
+	Version          int       `bson:"version"`
+	CategoryID       string    `bson:"categoryId"`
+	AttributeID      string    `bson:"attributeId"`
+	Required         bool      `bson:"required"`
+	SortOrder        int       `bson:"sortOrder"`
+	Filterable       *bool     `bson:"filterable,omitempty"`
+	Searchable       *bool     `bson:"searchable,omitempty"`
+	Enabled          bool      `bson:"enabled"`
+	CategoryDisabled bool      `bson:"categoryDisabled,omitempty"`
+	GroupID          *string   `bson:"groupId,omitempty"`
+	GroupSortOrder   int       `bson:"groupSortOrder"`
+	AllowedOptions   []string  `bson:"allowedOptions,omitempty"`
+	DefaultValues    []string  `bson:"defaultValues,omitempty"`
+	CreatedAt        time.Time `bson:"createdAt"`
+	ModifiedAt       time.Time `bson:"modifiedAt"`
 }
@@ -12,17 +12,22 @@ func newCategoryAttributeMapper() *categoryAttributeMapper {
 
 func (m *categoryAttributeMapper) ToEntity(ca *categoryattribute.CategoryAttribute) *categoryAttributeEntity {
 	return &categoryAttributeEntity{
-		ID:          ca.ID,
-		Version:     ca.Version,
-		CategoryID:  ca.CategoryID,
-		AttributeID: ca.AttributeID,
-		Required:    ca.Required,
-		SortOrder:   ca.SortOrder,
-		Filterable:  ca.Filterable,
-		Searchable:  ca.Searchable,
-		Enabled:     ca.Enabled,
-		CreatedAt:   ca.CreatedAt,
-		ModifiedAt:  ca.ModifiedAt,
+		ID:               ca.ID,
+		Version:          ca.Version,
+		CategoryID:       ca.CategoryID,
+		AttributeID:      ca.AttributeID,
+		Required:         ca.Required,
+		SortOrder:        ca.SortOrder,
+		Filterable:       ca.Filterable,
+		Searchable:       ca.Searchable,
+		Enabled:          ca.Enabled,
+		CategoryDisabled: ca.CategoryDisabled,
+		GroupID:          ca.GroupID,
+		GroupSortOrder:   ca.GroupSortOrder,
+		AllowedOptions:   ca.AllowedOptions,
+		DefaultValues:    ca.DefaultValues,
+		CreatedAt:        ca.CreatedAt,
+		ModifiedAt:       ca.ModifiedAt,
 	}
 }
 
@@ -37,6 +42,11 @@ func (m *categoryAttributeMapper) ToDomain(e *categoryAttributeEntity) *category
 		e.Filterable,
 		e.Searchable,
 		e.Enabled,
+		e.CategoryDisabled,
+		e.GroupID,
+		e.GroupSortOrder,
+		e.AllowedOptions,
+		e.DefaultValues,
 		e.CreatedAt.UTC(),
 		e.ModifiedAt.UTC(),
 	)
@@ -2,6 +2,7 @@ package mongo
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categoryattribute"
 	"github.com/Sokol111/ecommerce-commons/pkg/persistence"
@@ -12,6 +13,8 @@ import (
 
 type categoryAttributeRepository struct {
 	*commonsmongo.GenericRepository[categoryattribute.CategoryAttribute, categoryAttributeEntity]
+	collection commonsmongo.Collection
+	mapper     *categoryAttributeMapper
 }
 
 func newCategoryAttributeRepository(mongoClient commonsmongo.Mongo, mapper *categoryAttributeMapper) (categoryattribute.Repository, error) {
@@ -27,6 +30,8 @@ func newCategoryAttributeRepository(mongoClient commonsmongo.Mongo, mapper *cate
 
 	return &categoryAttributeRepository{
 		GenericRepository: genericRepo,
+		collection:        collection,
+		mapper:            mapper,
 	}, nil
 }
 
@@ -61,7 +66,13 @@ func (r *categoryAttributeRepository) FindList(ctx context.Context, query catego
 		filter = append(filter, bson.E{Key: "enabled", Value: *query.Enabled})
 	}
 	if query.Filterable != nil {
-		filter = append(filter, bson.E{Key: "filterable", Value: *query.Filterable})
+		filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "filterable", Value: *query.Filterable}},
+			bson.D{
+				{Key: "filterable", Value: nil},
+				{Key: "attributeId", Value: bson.D{{Key: "$in", Value: query.FilterableByDefault}}},
+			},
+		}})
 	}
 
 	var sortBson bson.D
@@ -106,3 +117,58 @@ func (r *categoryAttributeRepository) Update(ctx context.Context, ca *categoryat
 	}
 	return result, nil
 }
+
+func (r *categoryAttributeRepository) FindAllByAttributeID(ctx context.Context, attributeID string) ([]*categoryattribute.CategoryAttribute, error) {
+	return r.findAll(ctx, bson.D{{Key: "attributeId", Value: attributeID}})
+}
+
+// findAll returns every assignment matching the filter without pagination
+func (r *categoryAttributeRepository) findAll(ctx context.Context, filter bson.D) ([]*categoryattribute.CategoryAttribute, error) {
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query category attributes: %w", err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var entities []categoryAttributeEntity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode category attributes: %w", err)
+	}
+
+	items := make([]*categoryattribute.CategoryAttribute, 0, len(entities))
+	for i := range entities {
+		items = append(items, r.mapper.ToDomain(&entities[i]))
+	}
+	return items, nil
+}
+
+func (r *categoryAttributeRepository) DeleteByAttributeID(ctx context.Context, attributeID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.D{{Key: "attributeId", Value: attributeID}})
+	if err != nil {
+		return fmt.Errorf("failed to delete category attributes: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryAttributeRepository) FindAllByCategoryID(ctx context.Context, categoryID string) ([]*categoryattribute.CategoryAttribute, error) {
+	return r.findAll(ctx, bson.D{{Key: "categoryId", Value: categoryID}})
+}
+
+func (r *categoryAttributeRepository) FindAllByCategoryIDs(ctx context.Context, categoryIDs []string) ([]*categoryattribute.CategoryAttribute, error) {
+	if len(categoryIDs) == 0 {
+		return nil, nil
+	}
+	return r.findAll(ctx, bson.D{{Key: "categoryId", Value: bson.D{{Key: "$in", Value: categoryIDs}}}})
+}
+
+func (r *categoryAttributeRepository) DeleteByCategoryID(ctx context.Context, categoryID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.D{{Key: "categoryId", Value: categoryID}})
+	if err != nil {
+		return fmt.Errorf("failed to delete category attributes: %w", err)
+	}
+	return nil
+}
+
+func (r *categoryAttributeRepository) ExistsByGroupID(ctx context.Context, groupID string) (bool, error) {
+	return r.ExistsWithFilter(ctx, bson.D{{Key: "groupId", Value: groupID}})
+}
@@ -0,0 +1,23 @@
+package mongo
+
+import (
+	"time"
+)
+
+// categoryNodeEntity represents the MongoDB document structure for category tree nodes
+type categoryNodeEntity struct {
+	ID                   string    `bson:"_id"`
+	Version              int       `bson:"version"`
+	ParentID             *string   `bson:"parentId,omitempty"`
+	CategoryVersion      int       `bson:"categoryVersion"`
+	Deleted              bool      `bson:"deleted,omitempty"`
+	ExcludedAttributeIDs []string  `bson:"excludedAttributeIds,omitempty"`
+	CreatedAt            time.Time `bson:"createdAt"`
+	ModifiedAt           time.Time `bson:"modifiedAt"`
+}
+
+// categoryPathEntity is a category node with its ancestors found by $graphLookup, in no particular order
+type categoryPathEntity struct {
+	Node      categoryNodeEntity   `bson:"node"`
+	Ancestors []categoryNodeEntity `bson:"ancestors"`
+}
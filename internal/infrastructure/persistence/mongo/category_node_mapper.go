@@ -0,0 +1,49 @@
+package mongo
+
+import (
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+)
+
+type categoryNodeMapper struct{}
+
+func newCategoryNodeMapper() *categoryNodeMapper {
+	return &categoryNodeMapper{}
+}
+
+func (m *categoryNodeMapper) ToEntity(n *categorytree.Node) *categoryNodeEntity {
+	return &categoryNodeEntity{
+		ID:                   n.ID,
+		Version:              n.Version,
+		ParentID:             n.ParentID,
+		CategoryVersion:      n.CategoryVersion,
+		Deleted:              n.Deleted,
+		ExcludedAttributeIDs: n.ExcludedAttributeIDs,
+		CreatedAt:            n.CreatedAt,
+		ModifiedAt:           n.ModifiedAt,
+	}
+}
+
+func (m *categoryNodeMapper) ToDomain(e *categoryNodeEntity) *categorytree.Node {
+	return categorytree.Reconstruct(
+		e.ID,
+		e.Version,
+		e.ParentID,
+		e.CategoryVersion,
+		e.Deleted,
+		e.ExcludedAttributeIDs,
+		e.CreatedAt.UTC(),
+		e.ModifiedAt.UTC(),
+	)
+}
+
+func (m *categoryNodeMapper) GetID(e *categoryNodeEntity) string {
+	return e.ID
+}
+
+func (m *categoryNodeMapper) GetVersion(e *categoryNodeEntity) int {
+	return e.Version
+}
+
+func (m *categoryNodeMapper) SetVersion(e *categoryNodeEntity, version int) {
+	e.Version = version
+}
@@ -0,0 +1,105 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/categorytree"
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type categoryNodeRepository struct {
+	*commonsmongo.GenericRepository[categorytree.Node, categoryNodeEntity]
+	collection commonsmongo.Collection
+	mapper     *categoryNodeMapper
+}
+
+func newCategoryNodeRepository(mongoClient commonsmongo.Mongo, mapper *categoryNodeMapper) (categorytree.Repository, error) {
+	collection := mongoClient.GetCollection("category_node")
+
+	genericRepo, err := commonsmongo.NewGenericRepository(
+		collection,
+		mapper,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &categoryNodeRepository{
+		GenericRepository: genericRepo,
+		collection:        collection,
+		mapper:            mapper,
+	}, nil
+}
+
+// FindPath loads the node and its ancestors in a single $graphLookup round trip
+func (r *categoryNodeRepository) FindPath(ctx context.Context, id string) ([]*categorytree.Node, error) {
+	notDeleted := bson.D{{Key: "deleted", Value: bson.D{{Key: "$ne", Value: true}}}}
+	pipeline := bson.A{
+		bson.D{{Key: "$match", Value: append(bson.D{{Key: "_id", Value: id}}, notDeleted...)}},
+		bson.D{{Key: "$replaceRoot", Value: bson.D{{Key: "newRoot", Value: bson.D{{Key: "node", Value: "$$ROOT"}}}}}},
+		bson.D{{Key: "$graphLookup", Value: bson.D{
+			{Key: "from", Value: "category_node"},
+			{Key: "startWith", Value: "$node.parentId"},
+			{Key: "connectFromField", Value: "parentId"},
+			{Key: "connectToField", Value: "_id"},
+			{Key: "as", Value: "ancestors"},
+			// maxDepth 0 is the parent, so at most MaxDepth nodes are loaded
+			{Key: "maxDepth", Value: categorytree.MaxDepth - 2},
+			{Key: "restrictSearchWithMatch", Value: notDeleted},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query category path: %w", err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var entities []categoryPathEntity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode category path: %w", err)
+	}
+	if len(entities) == 0 {
+		return []*categorytree.Node{}, nil
+	}
+
+	ancestors := make(map[string]*categoryNodeEntity, len(entities[0].Ancestors))
+	for i := range entities[0].Ancestors {
+		ancestors[entities[0].Ancestors[i].ID] = &entities[0].Ancestors[i]
+	}
+
+	// Ancestors come unordered, follow the parent links; taking each ancestor once ends a cycle
+	path := []*categorytree.Node{r.mapper.ToDomain(&entities[0].Node)}
+	for next := entities[0].Node.ParentID; next != nil && len(path) < categorytree.MaxDepth; {
+		ancestor, ok := ancestors[*next]
+		if !ok {
+			break
+		}
+		delete(ancestors, *next)
+
+		path = append(path, r.mapper.ToDomain(ancestor))
+		next = ancestor.ParentID
+	}
+	return path, nil
+}
+
+func (r *categoryNodeRepository) FindChildren(ctx context.Context, parentID string) ([]*categorytree.Node, error) {
+	cursor, err := r.collection.Find(ctx, bson.D{{Key: "parentId", Value: parentID}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find child category nodes: %w", err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var entities []categoryNodeEntity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode child category nodes: %w", err)
+	}
+
+	nodes := make([]*categorytree.Node, 0, len(entities))
+	for i := range entities {
+		nodes = append(nodes, r.mapper.ToDomain(&entities[i]))
+	}
+	return nodes, nil
+}
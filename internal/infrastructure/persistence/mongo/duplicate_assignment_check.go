@@ -0,0 +1,118 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	commonsmongo "github.com/Sokol111/ecommerce-commons/pkg/persistence/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxReportedDuplicates limits how many duplicate groups are included in the startup error
+	maxReportedDuplicates = 20
+
+	// uniqueAssignmentIndex rules out duplicates once it exists, so the check runs only before it is created
+	uniqueAssignmentIndex = "category_attribute_category_attribute_unique_v1"
+)
+
+type duplicateAssignment struct {
+	Key struct {
+		CategoryID  string `bson:"categoryId"`
+		AttributeID string `bson:"attributeId"`
+	} `bson:"_id"`
+	IDs   []string `bson:"ids"`
+	Count int      `bson:"count"`
+}
+
+// PreMigrationChecks verifies existing data before migrations are applied.
+// Must be registered before the persistence module so its start hook runs first.
+func PreMigrationChecks() fx.Option {
+	return fx.Invoke(registerDuplicateAssignmentCheck)
+}
+
+func registerDuplicateAssignmentCheck(lc fx.Lifecycle, log *zap.Logger, mongoClient commonsmongo.Mongo, admin commonsmongo.Admin) {
+	collection := mongoClient.GetCollection("category_attribute")
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			indexed, err := hasIndex(ctx, admin.GetDatabase().Collection("category_attribute"), uniqueAssignmentIndex)
+			if err != nil {
+				return fmt.Errorf("failed to list category assignment indexes: %w", err)
+			}
+			if indexed {
+				return nil
+			}
+
+			duplicates, err := findDuplicateAssignments(ctx, collection)
+			if err != nil {
+				return fmt.Errorf("failed to check duplicate category assignments: %w", err)
+			}
+
+			if len(duplicates) == 0 {
+				return nil
+			}
+
+			report := make([]string, 0, len(duplicates))
+			for _, d := range duplicates {
+				log.Error("duplicate category assignment found",
+					zap.String("categoryId", d.Key.CategoryID),
+					zap.String("attributeId", d.Key.AttributeID),
+					zap.Strings("ids", d.IDs),
+				)
+				report = append(report, fmt.Sprintf("%s/%s (%d)", d.Key.CategoryID, d.Key.AttributeID, d.Count))
+			}
+
+			return fmt.Errorf("found duplicate category assignments, remove them before applying the unique index: %s",
+				strings.Join(report, ", "))
+		},
+	})
+}
+
+// hasIndex reports whether the collection has an index with the name.
+// A collection that does not exist yet has no indexes.
+func hasIndex(ctx context.Context, collection *mongo.Collection, name string) (bool, error) {
+	specs, err := collection.Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, spec := range specs {
+		if spec.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func findDuplicateAssignments(ctx context.Context, collection commonsmongo.Collection) ([]duplicateAssignment, error) {
+	pipeline := bson.A{
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "categoryId", Value: "$categoryId"},
+				{Key: "attributeId", Value: "$attributeId"},
+			}},
+			{Key: "ids", Value: bson.D{{Key: "$push", Value: "$_id"}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		bson.D{{Key: "$match", Value: bson.D{{Key: "count", Value: bson.D{{Key: "$gt", Value: 1}}}}}},
+		bson.D{{Key: "$limit", Value: maxReportedDuplicates}},
+	}
+
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var duplicates []duplicateAssignment
+	if err := cursor.All(ctx, &duplicates); err != nil {
+		return nil, err
+	}
+
+	return duplicates, nil
+}
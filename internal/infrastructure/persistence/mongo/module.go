@@ -11,5 +11,9 @@ func Module() fx.Option {
 		newAttributeRepository,
 		newCategoryAttributeMapper,
 		newCategoryAttributeRepository,
+		newAttributeGroupMapper,
+		newAttributeGroupRepository,
+		newCategoryNodeMapper,
+		newCategoryNodeRepository,
 	)
 }
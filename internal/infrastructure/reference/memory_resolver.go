@@ -0,0 +1,32 @@
+package reference
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+)
+
+type inMemoryResolver struct {
+	entities map[string]map[string]bool // entity kind -> known IDs
+}
+
+// NewInMemoryResolver resolves references against a fixed set of entity IDs keyed by entity kind.
+// Kinds missing from entities resolve no IDs.
+func NewInMemoryResolver(entities map[string][]string) attribute.ReferenceResolver {
+	return &inMemoryResolver{
+		entities: lo.MapValues(entities, func(ids []string, _ string) map[string]bool {
+			return lo.SliceToMap(ids, func(id string) (string, bool) {
+				return id, true
+			})
+		}),
+	}
+}
+
+func (r *inMemoryResolver) Resolve(_ context.Context, kind string, ids []string) ([]string, error) {
+	known := r.entities[kind]
+	return lo.Filter(ids, func(id string, _ int) bool {
+		return known[id]
+	}), nil
+}
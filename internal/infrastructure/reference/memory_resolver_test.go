@@ -0,0 +1,87 @@
+package reference
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+)
+
+func TestInMemoryResolverResolve(t *testing.T) {
+	resolver := NewInMemoryResolver(map[string][]string{
+		"brand":   {"acme", "globex"},
+		"country": {"ua"},
+	})
+
+	tests := []struct {
+		name string
+		kind string
+		ids  []string
+		want []string
+	}{
+		{name: "known ids", kind: "brand", ids: []string{"acme", "globex"}, want: []string{"acme", "globex"}},
+		{name: "unknown ids are dropped", kind: "brand", ids: []string{"acme", "initech"}, want: []string{"acme"}},
+		{name: "ids of another kind", kind: "country", ids: []string{"acme"}, want: nil},
+		{name: "unknown kind", kind: "manufacturer", ids: []string{"acme"}, want: nil},
+		{name: "no ids", kind: "brand", ids: nil, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolver.Resolve(context.Background(), tt.kind, tt.ids)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveReferencesWithInMemoryResolver(t *testing.T) {
+	a, err := attribute.NewAttribute("", "Brand", "brand", attribute.AttributeStatusActive, attribute.AttributeTypeReference,
+		nil, true, true, false, nil, attribute.TypeConfig{Reference: &attribute.ReferenceConfig{TargetKind: "brand"}, SwatchMode: attribute.SwatchModeText}, nil)
+	if err != nil {
+		t.Fatalf("NewAttribute() error = %v", err)
+	}
+	resolver := NewInMemoryResolver(map[string][]string{"brand": {"acme"}})
+
+	errs, err := a.ResolveReferences(context.Background(), resolver, []string{"acme"})
+	if err != nil {
+		t.Fatalf("ResolveReferences() error = %v", err)
+	}
+	if len(errs) != 0 {
+		t.Errorf("ResolveReferences() of a known id = %v, want no errors", errs)
+	}
+
+	errs, err = a.ResolveReferences(context.Background(), resolver, []string{"acme", "initech"})
+	if err != nil {
+		t.Fatalf("ResolveReferences() error = %v", err)
+	}
+	if len(errs) != 1 || errs[0].Code != attribute.ValueErrorUnknownReference || *errs[0].Value != "initech" {
+		t.Errorf("ResolveReferences() of an unknown id = %v, want one %s error for initech", errs, attribute.ValueErrorUnknownReference)
+	}
+}
+
+func TestNewResolver(t *testing.T) {
+	ctx := context.Background()
+
+	resolved, err := newResolver(Config{}).Resolve(ctx, "brand", []string{"acme"})
+	if err != nil || !slices.Equal(resolved, []string{"acme"}) {
+		t.Errorf("resolver without entities = %v, %v, want every id passed through", resolved, err)
+	}
+
+	resolver := newResolver(Config{Entities: map[string][]string{"brand": {"acme"}}})
+
+	resolved, err = resolver.Resolve(ctx, "brand", []string{"acme", "initech"})
+	if err != nil || !slices.Equal(resolved, []string{"acme"}) {
+		t.Errorf("resolver of a listed kind = %v, %v, want only the listed id", resolved, err)
+	}
+
+	resolved, err = resolver.Resolve(ctx, "country", []string{"ua"})
+	if err != nil || !slices.Equal(resolved, []string{"ua"}) {
+		t.Errorf("resolver of an unlisted kind = %v, %v, want every id passed through", resolved, err)
+	}
+}
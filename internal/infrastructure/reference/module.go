@@ -0,0 +1,64 @@
+package reference
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+)
+
+// Config lists the external entities reference attributes may point to.
+// Kinds listed here are checked against their IDs until the owning services expose lookups;
+// references to other kinds are passed through.
+type Config struct {
+	Entities map[string][]string `mapstructure:"entities"` // entity kind -> IDs
+}
+
+// Module provides the resolver of reference attribute values
+func Module() fx.Option {
+	return fx.Provide(
+		newConfig,
+		newResolver,
+	)
+}
+
+func newConfig(v *viper.Viper) (Config, error) {
+	cfg := Config{}
+
+	if sub := v.Sub("references"); sub != nil {
+		if err := sub.Unmarshal(&cfg); err != nil {
+			return cfg, fmt.Errorf("failed to load references config: %w", err)
+		}
+	}
+
+	return cfg, nil
+}
+
+func newResolver(cfg Config) attribute.ReferenceResolver {
+	if len(cfg.Entities) == 0 {
+		return NewPassThroughResolver()
+	}
+
+	return &kindResolver{
+		listed:   NewInMemoryResolver(cfg.Entities),
+		kinds:    cfg.Entities,
+		fallback: NewPassThroughResolver(),
+	}
+}
+
+// kindResolver resolves the configured kinds with listed and every other kind with fallback
+type kindResolver struct {
+	listed   attribute.ReferenceResolver
+	kinds    map[string][]string
+	fallback attribute.ReferenceResolver
+}
+
+func (r *kindResolver) Resolve(ctx context.Context, kind string, ids []string) ([]string, error) {
+	if _, ok := r.kinds[kind]; ok {
+		return r.listed.Resolve(ctx, kind, ids)
+	}
+	return r.fallback.Resolve(ctx, kind, ids)
+}
@@ -0,0 +1,18 @@
+package reference
+
+import (
+	"context"
+
+	"github.com/Sokol111/ecommerce-attribute-service/internal/domain/attribute"
+)
+
+type passThroughResolver struct{}
+
+// NewPassThroughResolver resolves every ID of every kind, leaving reference values to the format checks
+func NewPassThroughResolver() attribute.ReferenceResolver {
+	return passThroughResolver{}
+}
+
+func (passThroughResolver) Resolve(_ context.Context, _ string, ids []string) ([]string, error) {
+	return ids, nil
+}